@@ -2,6 +2,8 @@ package gt
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,8 +51,8 @@ func (x ArrayTest[T]) Describef(format string, args ...any) ArrayTest[T] {
 func (x ArrayTest[T]) Equal(expect []T) ArrayTest[T] {
 	x.t.Helper()
 
-	if !EvalCompare(x.actual, expect) {
-		msg := "arrays are not matched\n" + Diff(x.actual, expect)
+	if !evalCompare(x.t, x.actual, expect) {
+		msg := "arrays are not matched\n" + evalDiff(x.t, x.actual, expect)
 		x.t.Error(formatErrorMessage(x.description, msg))
 		return x
 	}
@@ -58,6 +60,21 @@ func (x ArrayTest[T]) Equal(expect []T) ArrayTest[T] {
 	return x
 }
 
+// EqualWith check if actual equals with expect by go-cmp with options. The options are applied to both comparison and diff output.
+//
+//	v := []int{3, 1, 2}
+//	gt.Array(t, v).EqualWith([]int{1, 2, 3}, gt.SortSlices(func(a, b int) bool { return a < b })) // Pass
+func (x ArrayTest[T]) EqualWith(expect []T, options ...CompareOption) ArrayTest[T] {
+	x.t.Helper()
+	cfg := newCompareConfig(options)
+	if !cfg.equal(expect, x.actual) {
+		msg := "arrays are not matched\n" + cfg.diff(expect, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
 // NotEqual check if actual does not equals with expect. Default evaluation function uses reflect.DeepEqual.
 //
 //	v := []int{1, 2, 3, 5}
@@ -66,7 +83,7 @@ func (x ArrayTest[T]) Equal(expect []T) ArrayTest[T] {
 func (x ArrayTest[T]) NotEqual(expect []T) ArrayTest[T] {
 	x.t.Helper()
 
-	if EvalCompare(x.actual, expect) {
+	if evalCompare(x.t, x.actual, expect) {
 		msg := fmt.Sprintf("arrays should not be matched, %+v", x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 		return x
@@ -87,7 +104,7 @@ func (x ArrayTest[T]) EqualAt(idx int, expect T) ArrayTest[T] {
 	if idx < 0 || len(x.actual) <= idx {
 		msg := fmt.Sprintf("array length is %d, then %d is out of range", len(x.actual), idx)
 		x.t.Error(formatErrorMessage(x.description, msg))
-	} else if !EvalCompare(x.actual[idx], expect) {
+	} else if !evalCompare(x.t, x.actual[idx], expect) {
 		msg := fmt.Sprintf("array[%d] is expected %+v, but actual is %+v", idx, expect, x.actual[idx])
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -107,7 +124,7 @@ func (x ArrayTest[T]) NotEqualAt(idx int, expect T) ArrayTest[T] {
 	if idx < 0 || len(x.actual) <= idx {
 		msg := fmt.Sprintf("array length is %d, then %d is out of range", len(x.actual), idx)
 		x.t.Error(formatErrorMessage(x.description, msg))
-	} else if EvalCompare(x.actual[idx], expect) {
+	} else if evalCompare(x.t, x.actual[idx], expect) {
 		msg := fmt.Sprintf("array[%d] is not expected %+v, but actual is %+v", idx, expect, x.actual[idx])
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -119,7 +136,7 @@ func (x ArrayTest[T]) has(expect T) bool {
 	x.t.Helper()
 
 	for i := range x.actual {
-		if EvalCompare(x.actual[i], expect) {
+		if evalCompare(x.t, x.actual[i], expect) {
 			return true
 		}
 	}
@@ -160,7 +177,7 @@ func (x ArrayTest[T]) contains(expect []T) bool {
 
 	check := func(i int) bool {
 		for j := range expect {
-			if i+j >= len(x.actual) || !EvalCompare(x.actual[i+j], expect[j]) {
+			if i+j >= len(x.actual) || !evalCompare(x.t, x.actual[i+j], expect[j]) {
 				return false
 			}
 		}
@@ -325,7 +342,7 @@ func (x ArrayTest[T]) Distinct() ArrayTest[T] {
 
 	for i := range x.actual {
 		for j := i + 1; j < len(x.actual); j++ {
-			if EvalCompare(x.actual[i], x.actual[j]) {
+			if evalCompare(x.t, x.actual[i], x.actual[j]) {
 				msg := fmt.Sprintf("array[%d] and array[%d] are not distinct (%+v)", i, j, x.actual[i])
 				x.t.Error(formatErrorMessage(x.description, msg))
 				return x
@@ -371,3 +388,323 @@ func (x ArrayTest[T]) MatchThen(match func(v T) bool, then func(t testing.TB, v
 	x.t.Error(formatErrorMessage(x.description, msg))
 	return x
 }
+
+// Sorted checks if actual array is sorted in ascending order. Equal adjacent elements are allowed. Only element types of integer, float and string kind are supported, and SortedBy should be used for other types.
+//
+//	gt.Array(t, []int{1, 2, 2, 5}).Sorted() // Pass
+//	gt.Array(t, []int{1, 3, 2}).Sorted()    // Fail
+func (x ArrayTest[T]) Sorted() ArrayTest[T] {
+	x.t.Helper()
+	x.checkSorted("ascending", func(a, b T) (bool, error) {
+		c, err := compareOrdered(a, b)
+		return c > 0, err
+	})
+	return x
+}
+
+// SortedDesc checks if actual array is sorted in descending order. Equal adjacent elements are allowed. Only element types of integer, float and string kind are supported, and SortedBy should be used for other types.
+//
+//	gt.Array(t, []int{5, 2, 2, 1}).SortedDesc() // Pass
+func (x ArrayTest[T]) SortedDesc() ArrayTest[T] {
+	x.t.Helper()
+	x.checkSorted("descending", func(a, b T) (bool, error) {
+		c, err := compareOrdered(a, b)
+		return c < 0, err
+	})
+	return x
+}
+
+// SortedBy checks if actual array is sorted by less. less must report whether a should be placed before b, as same as sort.Slice.
+//
+//	gt.Array(t, users).SortedBy(func(a, b User) bool {
+//		return a.Age < b.Age
+//	})
+func (x ArrayTest[T]) SortedBy(less func(a, b T) bool) ArrayTest[T] {
+	x.t.Helper()
+	x.checkSorted("specified order", func(a, b T) (bool, error) {
+		return less(b, a), nil
+	})
+	return x
+}
+
+// checkSorted reports the first pair of adjacent elements that are out of order. outOfOrder returns true if a must not be placed before b.
+func (x ArrayTest[T]) checkSorted(order string, outOfOrder func(a, b T) (bool, error)) {
+	x.t.Helper()
+
+	for i := 1; i < len(x.actual); i++ {
+		ng, err := outOfOrder(x.actual[i-1], x.actual[i])
+		if err != nil {
+			x.t.Error(formatErrorMessage(x.description, err.Error()))
+			return
+		}
+		if ng {
+			msg := fmt.Sprintf("array is not sorted in %s, array[%d] (%+v) and array[%d] (%+v) are out of order", order, i-1, x.actual[i-1], i, x.actual[i])
+			x.t.Error(formatErrorMessage(x.description, msg))
+			return
+		}
+	}
+}
+
+// compareOrdered compares a and b by reflection and returns -1, 0 or +1. It returns error if kind of a and b is not ordered.
+func compareOrdered(a, b any) (int, error) {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !va.IsValid() || !vb.IsValid() || va.Type() != vb.Type() {
+		return 0, fmt.Errorf("%T and %T can not be compared, use SortedBy instead", a, b)
+	}
+
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareValues(va.Int(), vb.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareValues(va.Uint(), vb.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return compareValues(va.Float(), vb.Float()), nil
+	case reflect.String:
+		return compareValues(va.String(), vb.String()), nil
+	default:
+		return 0, fmt.Errorf("%T is not ordered type, use SortedBy instead", a)
+	}
+}
+
+func compareValues[T int64 | uint64 | float64 | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsSubsetOf checks if all elements of actual array are in set. Number of occurrence is not considered.
+//
+//	gt.Array(t, []int{1, 3}).IsSubsetOf([]int{1, 2, 3}) // Pass
+//	gt.Array(t, []int{1, 4}).IsSubsetOf([]int{1, 2, 3}) // Fail
+func (x ArrayTest[T]) IsSubsetOf(set []T) ArrayTest[T] {
+	x.t.Helper()
+
+	var missing []T
+	for _, v := range x.actual {
+		if !x.in(set, v) {
+			missing = append(missing, v)
+		}
+	}
+
+	if len(missing) > 0 {
+		msg := fmt.Sprintf("%+v expects to be subset of %+v, but %+v are not in the set", x.actual, set, missing)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// IsSupersetOf checks if actual array has all elements of set. Number of occurrence is not considered.
+//
+//	gt.Array(t, []int{1, 2, 3}).IsSupersetOf([]int{1, 3}) // Pass
+//	gt.Array(t, []int{1, 2, 3}).IsSupersetOf([]int{1, 4}) // Fail
+func (x ArrayTest[T]) IsSupersetOf(set []T) ArrayTest[T] {
+	x.t.Helper()
+
+	var missing []T
+	for _, v := range set {
+		if !x.has(v) {
+			missing = append(missing, v)
+		}
+	}
+
+	if len(missing) > 0 {
+		msg := fmt.Sprintf("%+v expects to be superset of %+v, but %+v are not in the array", x.actual, set, missing)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+func (x ArrayTest[T]) in(set []T, v T) bool {
+	x.t.Helper()
+	for i := range set {
+		if evalCompare(x.t, set[i], v) {
+			return true
+		}
+	}
+	return false
+}
+
+// EqualUnordered checks if actual array and expect have the same elements with the same number of occurrence, regardless of order. If not, extra elements (in actual, not in expect) and missing elements (in expect, not in actual) are reported.
+//
+//	gt.Array(t, []int{3, 1, 2, 1}).EqualUnordered([]int{1, 1, 2, 3}) // Pass
+//	gt.Array(t, []int{3, 1, 2}).EqualUnordered([]int{1, 2, 2})       // Fail
+func (x ArrayTest[T]) EqualUnordered(expect []T) ArrayTest[T] {
+	x.t.Helper()
+
+	matched := make([]bool, len(expect))
+	var extra []T
+	for _, v := range x.actual {
+		found := false
+		for j := range expect {
+			if !matched[j] && evalCompare(x.t, v, expect[j]) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			extra = append(extra, v)
+		}
+	}
+
+	var missing []T
+	for j := range expect {
+		if !matched[j] {
+			missing = append(missing, expect[j])
+		}
+	}
+
+	if len(extra) > 0 || len(missing) > 0 {
+		var b strings.Builder
+		b.WriteString("arrays are not matched regardless of order")
+		if len(extra) > 0 {
+			fmt.Fprintf(&b, "\nextra: %+v", extra)
+		}
+		if len(missing) > 0 {
+			fmt.Fprintf(&b, "\nmissing: %+v", missing)
+		}
+		x.t.Error(formatErrorMessage(x.description, b.String()))
+	}
+	return x
+}
+
+// StartsWith checks if actual array begins with prefix.
+//
+//	gt.Array(t, []int{1, 2, 3}).StartsWith([]int{1, 2}) // Pass
+//	gt.Array(t, []int{1, 2, 3}).StartsWith([]int{2, 3}) // Fail
+func (x ArrayTest[T]) StartsWith(prefix []T) ArrayTest[T] {
+	x.t.Helper()
+	if !x.matchAt(0, prefix) {
+		msg := fmt.Sprintf("%+v expects to start with %+v", x.actual, prefix)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// EndsWith checks if actual array ends with suffix.
+//
+//	gt.Array(t, []int{1, 2, 3}).EndsWith([]int{2, 3}) // Pass
+//	gt.Array(t, []int{1, 2, 3}).EndsWith([]int{1, 2}) // Fail
+func (x ArrayTest[T]) EndsWith(suffix []T) ArrayTest[T] {
+	x.t.Helper()
+	if !x.matchAt(len(x.actual)-len(suffix), suffix) {
+		msg := fmt.Sprintf("%+v expects to end with %+v", x.actual, suffix)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// matchAt returns true if items are in actual array from offset.
+func (x ArrayTest[T]) matchAt(offset int, items []T) bool {
+	x.t.Helper()
+	if offset < 0 || len(x.actual) < offset+len(items) {
+		return false
+	}
+	for i := range items {
+		if !evalCompare(x.t, x.actual[offset+i], items[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// InOrder checks if items appear in actual array in the given order. Other elements may appear between them (subsequence).
+//
+//	v := []string{"init", "load", "start", "stop"}
+//	gt.Array(t, v).InOrder("init", "start")  // Pass
+//	gt.Array(t, v).InOrder("start", "load")  // Fail
+func (x ArrayTest[T]) InOrder(items ...T) ArrayTest[T] {
+	x.t.Helper()
+
+	next := 0
+	for i := 0; i < len(x.actual) && next < len(items); i++ {
+		if evalCompare(x.t, x.actual[i], items[next]) {
+			next++
+		}
+	}
+
+	if next < len(items) {
+		msg := fmt.Sprintf("%+v expects to have %+v in order, but %+v (items[%d]) is not found after previous items", x.actual, items, items[next], next)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// Each calls f with testing.TB, index and each element in the array. Failures in f are reported with the index prefix like "[2] values are not matched", and t.FailNow() (e.g. by Required()) in f stops only the call for the element. If EachSubtest option is given, f is called in a subtest named by the index.
+//
+//	gt.Array(t, users).Each(func(t testing.TB, i int, u User) {
+//		gt.Value(t, u.ID).NotEqual(0)
+//		gt.String(t, u.Name).IsNotEmpty()
+//	})
+func (x ArrayTest[T]) Each(f func(t testing.TB, i int, v T), options ...EachOption) ArrayTest[T] {
+	x.t.Helper()
+	cfg := newEachConfig(options)
+
+	for i := range x.actual {
+		i, v := i, x.actual[i]
+		x.runEach(cfg, fmt.Sprintf("%d", i), func(t testing.TB) {
+			f(t, i, v)
+		})
+	}
+
+	return x
+}
+
+// Count checks if number of elements that f returns true is n.
+//
+//	v := []int{1, 2, 3, 4}
+//	gt.Array(t, v).Count(func(v int) bool { return v%2 == 0 }, 2) // Pass
+//	gt.Array(t, v).Count(func(v int) bool { return v > 3 }, 2)    // Fail
+func (x ArrayTest[T]) Count(f func(v T) bool, n int) ArrayTest[T] {
+	x.t.Helper()
+
+	var count int
+	for i := range x.actual {
+		if f(x.actual[i]) {
+			count++
+		}
+	}
+
+	if count != n {
+		msg := fmt.Sprintf("number of matched elements is expected to be %d, but actual is %d", n, count)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// None calls f with each elements in the array. If f returns true for any element, None will trigger error with the index and the element.
+//
+//	v := []int{1, 2, 3, 5}
+//	gt.Array(t, v).None(func(v int) bool {
+//	    return v > 5
+//	}) // Pass
+//	gt.Array(t, v).None(func(v int) bool {
+//	    return v == 3
+//	}) // Fail
+func (x ArrayTest[T]) None(f func(v T) bool) ArrayTest[T] {
+	x.t.Helper()
+
+	for i := range x.actual {
+		if f(x.actual[i]) {
+			msg := fmt.Sprintf("array[%d] is matched, but no element is expected to match: %+v", i, x.actual[i])
+			x.t.Error(formatErrorMessage(x.description, msg))
+			return x
+		}
+	}
+	return x
+}
+
+// Len returns NumberTest of length of the array. testing.TB and description are kept.
+//
+//	gt.Array(t, users).Len().Between(1, 10)
+func (x ArrayTest[T]) Len() NumberTest[int] {
+	x.t.Helper()
+	return NumberTest[int]{
+		TestMeta: x.TestMeta,
+		actual:   len(x.actual),
+	}
+}
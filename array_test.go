@@ -2,6 +2,7 @@ package gt_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/m-mizutani/gt"
@@ -301,6 +302,183 @@ func TestArray(t *testing.T) {
 				pass: true,
 			},
 		},
+
+		"Sorted": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.Sorted()
+				},
+				pass: true,
+			},
+		},
+
+		"SortedDesc": {
+			"fail": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.SortedDesc()
+				},
+				pass: false,
+			},
+		},
+
+		"SortedBy": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.SortedBy(func(a, b string) bool { return a < b })
+				},
+				pass: true,
+			},
+			"fail": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.SortedBy(func(a, b string) bool { return len(a) < len(b) })
+				},
+				pass: false,
+			},
+		},
+
+		"IsSubsetOf": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.IsSubsetOf([]string{"red", "white", "orange", "blue"})
+				},
+				pass: true,
+			},
+			"fail": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.IsSubsetOf([]string{"red", "blue"})
+				},
+				pass: false,
+			},
+		},
+
+		"IsSupersetOf": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.IsSupersetOf([]string{"red", "blue"})
+				},
+				pass: true,
+			},
+			"fail": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.IsSupersetOf([]string{"red", "white"})
+				},
+				pass: false,
+			},
+		},
+
+		"EqualUnordered": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.EqualUnordered([]string{"red", "blue", "orange"})
+				},
+				pass: true,
+			},
+			"fail with missing": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.EqualUnordered([]string{"red", "blue", "orange", "red"})
+				},
+				pass: false,
+			},
+			"fail with extra": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.EqualUnordered([]string{"red", "blue"})
+				},
+				pass: false,
+			},
+		},
+
+		"StartsWith": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.StartsWith([]string{"blue", "orange"})
+				},
+				pass: true,
+			},
+			"pass with empty": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.StartsWith(nil)
+				},
+				pass: true,
+			},
+			"fail": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.StartsWith([]string{"orange"})
+				},
+				pass: false,
+			},
+			"fail with longer prefix": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.StartsWith([]string{"blue", "orange", "red", "white"})
+				},
+				pass: false,
+			},
+		},
+
+		"EndsWith": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.EndsWith([]string{"orange", "red"})
+				},
+				pass: true,
+			},
+			"fail": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.EndsWith([]string{"orange"})
+				},
+				pass: false,
+			},
+		},
+
+		"Count": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.Count(func(v string) bool { return strings.Contains(v, "r") }, 2)
+				},
+				pass: true,
+			},
+			"fail": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.Count(func(v string) bool { return strings.Contains(v, "r") }, 3)
+				},
+				pass: false,
+			},
+		},
+
+		"None": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.None(func(v string) bool { return v == "white" })
+				},
+				pass: true,
+			},
+			"fail": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.None(func(v string) bool { return v == "red" })
+				},
+				pass: false,
+			},
+		},
+
+		"InOrder": {
+			"pass": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.InOrder("blue", "red")
+				},
+				pass: true,
+			},
+			"fail with reversed order": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.InOrder("red", "blue")
+				},
+				pass: false,
+			},
+			"fail with missing item": {
+				test: func(arr gt.ArrayTest[string]) {
+					arr.InOrder("blue", "white")
+				},
+				pass: false,
+			},
+		},
 	}
 
 	for feature, cases := range testCases {
@@ -431,3 +609,72 @@ func TestMatchThen(t *testing.T) {
 		}
 	})
 }
+
+func TestArraySorted(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+
+	testCases := map[string]struct {
+		test func(t testing.TB)
+		pass bool
+	}{
+		"Sorted pass with duplicated": {
+			test: func(t testing.TB) { gt.Array(t, []int{1, 2, 2, 5}).Sorted() },
+			pass: true,
+		},
+		"Sorted fail": {
+			test: func(t testing.TB) { gt.Array(t, []int{1, 3, 2}).Sorted() },
+			pass: false,
+		},
+		"Sorted pass with empty": {
+			test: func(t testing.TB) { gt.Array(t, []float64{}).Sorted() },
+			pass: true,
+		},
+		"Sorted fail with unordered type": {
+			test: func(t testing.TB) { gt.Array(t, []user{{"a", 1}, {"b", 2}}).Sorted() },
+			pass: false,
+		},
+		"SortedDesc pass": {
+			test: func(t testing.TB) { gt.Array(t, []uint8{5, 2, 2, 1}).SortedDesc() },
+			pass: true,
+		},
+		"SortedBy pass with struct": {
+			test: func(t testing.TB) {
+				gt.Array(t, []user{{"b", 1}, {"a", 2}}).SortedBy(func(a, b user) bool { return a.Age < b.Age })
+			},
+			pass: true,
+		},
+		"EqualUnordered pass with duplicated": {
+			test: func(t testing.TB) { gt.Array(t, []int{3, 1, 2, 1}).EqualUnordered([]int{1, 1, 2, 3}) },
+			pass: true,
+		},
+		"EqualUnordered fail with multiplicity": {
+			test: func(t testing.TB) { gt.Array(t, []int{1, 2, 2}).EqualUnordered([]int{1, 1, 2}) },
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(r)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestArrayOrderMessage(t *testing.T) {
+	r := newRecorder()
+	gt.Array(r, []int{3, 1, 2}).EqualUnordered([]int{1, 2, 2, 4})
+	gt.Number(t, r.errs).Equal(1)
+	gt.String(t, r.msgs[0]).Contains("extra: [3]").Contains("missing: [2 4]")
+
+	r = newRecorder()
+	gt.Array(r, []int{1, 3, 2}).Sorted()
+	gt.Number(t, r.errs).Equal(1)
+	gt.String(t, r.msgs[0]).Contains("array[1] (3) and array[2] (2) are out of order")
+}
@@ -0,0 +1,256 @@
+package gt
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type BytesTest struct {
+	TestMeta
+	actual []byte
+}
+
+// Bytes provides BytesTest that has comparison methods for byte sequence. Failure messages show bytes as hex dump instead of decimal slice.
+//
+//	gt.Bytes(t, encoded).
+//		HasPrefix([]byte{0x89, 'P', 'N', 'G'}).
+//		Length(128)
+func Bytes(t testing.TB, actual []byte) BytesTest {
+	t.Helper()
+	return BytesTest{
+		TestMeta: TestMeta{t: t},
+		actual:   actual,
+	}
+}
+
+// Describe sets a description for the test. The description will be displayed when the test fails.
+func (x BytesTest) Describe(description string) BytesTest {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when the test fails.
+func (x BytesTest) Describef(format string, args ...any) BytesTest {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test. If errors has been occurred in previous test, it immediately stop test by t.FailNow().
+func (x BytesTest) Required() BytesTest {
+	x.requiredWithMeta()
+	return x
+}
+
+// Equal checks if actual equals with expect. nil and empty slice are considered as equal. On failure, side-by-side hex dump of expect and actual around the first mismatching offset is shown.
+//
+//	gt.Bytes(t, []byte{0x01, 0x02}).Equal([]byte{0x01, 0x02}) // Pass
+//	gt.Bytes(t, []byte{0x01, 0x02}).Equal([]byte{0x01, 0x03}) // Fail
+func (x BytesTest) Equal(expect []byte) BytesTest {
+	x.t.Helper()
+	if !bytes.Equal(x.actual, expect) {
+		msg := "bytes are not matched\n" + hexDiff(expect, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// EqualString checks if actual equals with expect as text payload. If actual is valid UTF-8 text, diff is shown as same as StringTest.Equal. Otherwise, hex dump diff is shown.
+//
+//	gt.Bytes(t, body).EqualString(`{"status":"ok"}`)
+func (x BytesTest) EqualString(expect string) BytesTest {
+	x.t.Helper()
+	if string(x.actual) == expect {
+		return x
+	}
+
+	var diff string
+	if isBinary(x.actual) {
+		diff = hexDiff([]byte(expect), x.actual)
+	} else {
+		diff = diffString(x.t, expect, string(x.actual))
+	}
+	x.t.Error(formatErrorMessage(x.description, "bytes are not matched\n"+diff))
+
+	return x
+}
+
+// HasPrefix checks if actual begins with prefix.
+//
+//	gt.Bytes(t, []byte("\x89PNG\r\n")).HasPrefix([]byte("\x89PNG")) // Pass
+func (x BytesTest) HasPrefix(prefix []byte) BytesTest {
+	x.t.Helper()
+	if !bytes.HasPrefix(x.actual, prefix) {
+		msg := fmt.Sprintf("bytes should have prefix %s, but actual is %s", formatHex(prefix), formatHex(x.actual))
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// HasSuffix checks if actual ends with suffix.
+//
+//	gt.Bytes(t, []byte{0x01, 0x02, 0xff}).HasSuffix([]byte{0xff}) // Pass
+func (x BytesTest) HasSuffix(suffix []byte) BytesTest {
+	x.t.Helper()
+	if !bytes.HasSuffix(x.actual, suffix) {
+		msg := fmt.Sprintf("bytes should have suffix %s, but actual is %s", formatHex(suffix), formatHex(x.actual))
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Contains checks if actual contains sub as continuous byte sequence.
+//
+//	gt.Bytes(t, []byte{0x01, 0x02, 0x03}).Contains([]byte{0x02, 0x03}) // Pass
+func (x BytesTest) Contains(sub []byte) BytesTest {
+	x.t.Helper()
+	if !bytes.Contains(x.actual, sub) {
+		msg := fmt.Sprintf("bytes should contain %s, but actual is %s", formatHex(sub), formatHex(x.actual))
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Length checks if length of actual is expect.
+//
+//	gt.Bytes(t, []byte{0x01, 0x02}).Length(2) // Pass
+func (x BytesTest) Length(expect int) BytesTest {
+	x.t.Helper()
+	if len(x.actual) != expect {
+		msg := fmt.Sprintf("bytes length is expected to be %d, but actual is %d", expect, len(x.actual))
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// maxFormatHexBytes limits number of bytes shown by formatHex.
+const maxFormatHexBytes = 32
+
+// formatHex formats b as space separated hex bytes like "[01 02 ff] (3 bytes)". Long data is truncated.
+func formatHex(b []byte) string {
+	shown := b
+	if len(shown) > maxFormatHexBytes {
+		shown = shown[:maxFormatHexBytes]
+	}
+
+	hexes := make([]string, len(shown))
+	for i, c := range shown {
+		hexes[i] = fmt.Sprintf("%02x", c)
+	}
+	s := strings.Join(hexes, " ")
+	if len(shown) < len(b) {
+		s += " ..."
+	}
+	return fmt.Sprintf("[%s] (%d bytes)", s, len(b))
+}
+
+const (
+	hexDiffRowBytes = 8
+	hexDiffContext  = 2
+	hexDiffMaxRows  = 32
+)
+
+// hexDiff renders side-by-side hex dump of expect and actual. Rows that have differences are marked with "!", and the first mismatching byte is pointed by "^^" in both sides. Only rows around differences are shown.
+func hexDiff(expect, actual []byte) string {
+	first := 0
+	for first < len(expect) && first < len(actual) && expect[first] == actual[first] {
+		first++
+	}
+
+	size := len(expect)
+	if len(actual) > size {
+		size = len(actual)
+	}
+	rows := (size + hexDiffRowBytes - 1) / hexDiffRowBytes
+
+	differs := func(row int) bool {
+		for i := row * hexDiffRowBytes; i < (row+1)*hexDiffRowBytes && i < size; i++ {
+			if i >= len(expect) || i >= len(actual) || expect[i] != actual[i] {
+				return true
+			}
+		}
+		return false
+	}
+
+	show := make([]bool, rows)
+	for row := 0; row < rows; row++ {
+		if !differs(row) {
+			continue
+		}
+		for r := row - hexDiffContext; r <= row+hexDiffContext; r++ {
+			if 0 <= r && r < rows {
+				show[r] = true
+			}
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "first mismatch at offset %d (0x%x), expect %d bytes, actual %d bytes\n", first, first, len(expect), len(actual))
+
+	// column of hex part of expect and actual in a row
+	const hexColumn = 12
+	actualColumn := hexColumn + hexDiffRowBytes*3 - 1 + 2 + hexDiffRowBytes + 2 + 4
+	fmt.Fprintf(&b, "  %-8s  %-*s%s\n", "offset", actualColumn-hexColumn, "expect", "actual")
+
+	shown, skipped := 0, false
+	for row := 0; row < rows; row++ {
+		if !show[row] {
+			skipped = true
+			continue
+		}
+		if shown >= hexDiffMaxRows {
+			b.WriteString("  ...\n")
+			break
+		}
+		if skipped && shown > 0 {
+			b.WriteString("  ...\n")
+		}
+		skipped = false
+		shown++
+
+		mark := " "
+		if differs(row) {
+			mark = "!"
+		}
+		offset := row * hexDiffRowBytes
+		fmt.Fprintf(&b, "%s %08x  %s    %s\n", mark, offset, hexDumpRow(expect, offset), hexDumpRow(actual, offset))
+
+		if offset <= first && first < offset+hexDiffRowBytes {
+			col := 3 * (first - offset)
+			line := []byte(strings.Repeat(" ", actualColumn+col+2))
+			copy(line[hexColumn+col:], "^^")
+			copy(line[actualColumn+col:], "^^")
+			b.Write(line)
+			b.WriteString("\n")
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// hexDumpRow renders hexDiffRowBytes bytes of data from offset as hex and printable characters. Bytes beyond data are rendered as spaces.
+func hexDumpRow(data []byte, offset int) string {
+	hexes := make([]string, hexDiffRowBytes)
+	chars := make([]byte, hexDiffRowBytes)
+	for i := 0; i < hexDiffRowBytes; i++ {
+		if offset+i < len(data) {
+			c := data[offset+i]
+			hexes[i] = fmt.Sprintf("%02x", c)
+			if 0x20 <= c && c < 0x7f {
+				chars[i] = c
+			} else {
+				chars[i] = '.'
+			}
+		} else {
+			hexes[i] = "  "
+			chars[i] = ' '
+		}
+	}
+	return strings.Join(hexes, " ") + "  |" + string(chars) + "|"
+}
@@ -0,0 +1,117 @@
+package gt_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestBytes(t *testing.T) {
+	data := []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a}
+
+	testCases := map[string]struct {
+		test func(b gt.BytesTest)
+		pass bool
+	}{
+		"Equal pass": {
+			test: func(b gt.BytesTest) { b.Equal([]byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a}) },
+			pass: true,
+		},
+		"Equal fail": {
+			test: func(b gt.BytesTest) { b.Equal([]byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0b}) },
+			pass: false,
+		},
+		"Equal fail by length": {
+			test: func(b gt.BytesTest) { b.Equal(data[:4]) },
+			pass: false,
+		},
+		"HasPrefix pass": {
+			test: func(b gt.BytesTest) { b.HasPrefix([]byte("\x89PNG")) },
+			pass: true,
+		},
+		"HasPrefix fail": {
+			test: func(b gt.BytesTest) { b.HasPrefix([]byte("GIF8")) },
+			pass: false,
+		},
+		"HasSuffix pass": {
+			test: func(b gt.BytesTest) { b.HasSuffix([]byte{0x1a, 0x0a}) },
+			pass: true,
+		},
+		"HasSuffix fail": {
+			test: func(b gt.BytesTest) { b.HasSuffix([]byte{0x0a, 0x1a}) },
+			pass: false,
+		},
+		"Contains pass": {
+			test: func(b gt.BytesTest) { b.Contains([]byte{'G', 0x0d}) },
+			pass: true,
+		},
+		"Contains fail": {
+			test: func(b gt.BytesTest) { b.Contains([]byte{0x00}) },
+			pass: false,
+		},
+		"Length pass": {
+			test: func(b gt.BytesTest) { b.Length(8) },
+			pass: true,
+		},
+		"Length fail": {
+			test: func(b gt.BytesTest) { b.Length(7) },
+			pass: false,
+		},
+		"EqualString fail": {
+			test: func(b gt.BytesTest) { b.EqualString("PNG") },
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.Bytes(r, data))
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestBytesEqualString(t *testing.T) {
+	gt.Bytes(t, []byte(`{"status":"ok"}`)).EqualString(`{"status":"ok"}`)
+
+	r := newRecorder()
+	gt.Bytes(r, []byte("line1\nline2\n")).EqualString("line1\nline-2\n")
+	gt.Number(t, r.errs).Equal(1)
+	gt.S(t, r.msgs[0]).Contains("-line-2\n+line2")
+
+	r = newRecorder()
+	gt.Bytes(r, []byte{0x00, 0x01}).EqualString("ab")
+	gt.Number(t, r.errs).Equal(1)
+	gt.S(t, r.msgs[0]).Contains("first mismatch at offset 0")
+}
+
+func TestBytesHexDiff(t *testing.T) {
+	expect := []byte(strings.Repeat("a", 64) + "xyz")
+	actual := []byte(strings.Repeat("a", 64) + "xYz!")
+
+	r := newRecorder()
+	gt.Bytes(r, actual).Describe("payload").Equal(expect)
+	gt.Number(t, r.errs).Equal(1)
+
+	lines := strings.Split(r.msgs[0], "\n")
+	gt.A(t, lines).Length(8)
+	gt.S(t, lines[0]).Equal("payload")
+	gt.S(t, lines[1]).Equal("bytes are not matched")
+	gt.S(t, lines[2]).Equal("first mismatch at offset 65 (0x41), expect 67 bytes, actual 68 bytes")
+	gt.S(t, lines[3]).HasPrefix("  offset    expect").Contains("actual")
+	gt.S(t, lines[4]).Equal("  00000030  61 61 61 61 61 61 61 61  |aaaaaaaa|    61 61 61 61 61 61 61 61  |aaaaaaaa|")
+	gt.S(t, lines[5]).Equal("  00000038  61 61 61 61 61 61 61 61  |aaaaaaaa|    61 61 61 61 61 61 61 61  |aaaaaaaa|")
+	gt.S(t, lines[6]).Equal("! 00000040  78 79 7a                 |xyz     |    78 59 7a 21              |xYz!    |")
+	gt.S(t, lines[7]).Equal(strings.Repeat(" ", 15) + "^^" + strings.Repeat(" ", 37) + "^^")
+}
+
+func TestBytesFormatHex(t *testing.T) {
+	r := newRecorder()
+	gt.Bytes(r, []byte{0x01, 0xff}).HasPrefix([]byte{0x02})
+	gt.Number(t, r.errs).Equal(1)
+	gt.S(t, r.msgs[0]).Equal("bytes should have prefix [02] (1 bytes), but actual is [01 ff] (2 bytes)")
+}
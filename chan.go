@@ -0,0 +1,242 @@
+package gt
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+type ChanTest[T any] struct {
+	TestMeta
+	actual <-chan T
+}
+
+// Chan provides ChanTest that has receive and close test methods for channel. Note that some methods (Receive, ReceiveAll, Drain, Closed, NotClosed and NoReceiveWithin) receive values from the channel and received values are consumed.
+//
+//	ch := make(chan int, 1)
+//	ch <- 5
+//	gt.Chan(t, ch).Receive(time.Second).Equal(5) // Pass
+func Chan[T any](t testing.TB, actual <-chan T) ChanTest[T] {
+	t.Helper()
+	return ChanTest[T]{
+		TestMeta: TestMeta{t: t},
+		actual:   actual,
+	}
+}
+
+// Ch is sugar syntax of Chan
+func Ch[T any](t testing.TB, actual <-chan T) ChanTest[T] {
+	t.Helper()
+	return Chan(t, actual)
+}
+
+// Describe sets a description for the test. The description will be displayed when the test fails.
+func (x ChanTest[T]) Describe(description string) ChanTest[T] {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when the test fails.
+func (x ChanTest[T]) Describef(format string, args ...any) ChanTest[T] {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test. If errors has been occurred in previous test, it immediately stop test by t.FailNow().
+func (x ChanTest[T]) Required() ChanTest[T] {
+	x.requiredWithMeta()
+	return x
+}
+
+// Receive receives a value from the channel within timeout and provides ValueTest of the received value. If no value is received within timeout or the channel is closed, test will fail and ValueTest has zero value.
+//
+//	ch := make(chan string, 1)
+//	ch <- "blue"
+//	gt.Chan(t, ch).Receive(time.Second).Equal("blue") // Pass
+func (x ChanTest[T]) Receive(timeout time.Duration) ValueTest[T] {
+	x.t.Helper()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	var v T
+	select {
+	case recv, ok := <-x.actual:
+		if !ok {
+			msg := "expected to receive a value, but channel is closed"
+			x.t.Error(formatErrorMessage(x.description, msg))
+		} else {
+			v = recv
+		}
+
+	case <-timer.C:
+		msg := fmt.Sprintf("expected to receive a value within %v, but timed out", timeout)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return ValueTest[T]{
+		TestMeta: x.TestMeta,
+		actual:   v,
+	}
+}
+
+// ReceiveAll receives values until the channel is closed and provides ArrayTest of received values. If the channel is not closed within timeout, test will fail and ArrayTest has values received before timeout.
+//
+//	ch := make(chan int, 3)
+//	ch <- 1
+//	ch <- 2
+//	close(ch)
+//	gt.Chan(t, ch).ReceiveAll(time.Second).Equal([]int{1, 2}) // Pass
+func (x ChanTest[T]) ReceiveAll(timeout time.Duration) ArrayTest[T] {
+	x.t.Helper()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	var values []T
+	for {
+		select {
+		case v, ok := <-x.actual:
+			if !ok {
+				return ArrayTest[T]{TestMeta: x.TestMeta, actual: values}
+			}
+			values = append(values, v)
+
+		case <-timer.C:
+			msg := fmt.Sprintf("expected channel to be closed within %v, but timed out (received %d values)", timeout, len(values))
+			x.t.Error(formatErrorMessage(x.description, msg))
+			return ArrayTest[T]{TestMeta: x.TestMeta, actual: values}
+		}
+	}
+}
+
+// Drain receives all values that are immediately available without blocking and provides ArrayTest of received values. Drain stops at an empty channel or a closed channel.
+//
+//	ch := make(chan int, 3)
+//	ch <- 1
+//	ch <- 2
+//	gt.Chan(t, ch).Drain().Equal([]int{1, 2}) // Pass
+func (x ChanTest[T]) Drain() ArrayTest[T] {
+	x.t.Helper()
+
+	var values []T
+	for {
+		select {
+		case v, ok := <-x.actual:
+			if !ok {
+				return ArrayTest[T]{TestMeta: x.TestMeta, actual: values}
+			}
+			values = append(values, v)
+
+		default:
+			return ArrayTest[T]{TestMeta: x.TestMeta, actual: values}
+		}
+	}
+}
+
+// Closed checks if the channel is closed within timeout. If a value is received before close, test will fail.
+//
+//	ch := make(chan int)
+//	close(ch)
+//	gt.Chan(t, ch).Closed(time.Second) // Pass
+func (x ChanTest[T]) Closed(timeout time.Duration) ChanTest[T] {
+	x.t.Helper()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case v, ok := <-x.actual:
+		if ok {
+			msg := fmt.Sprintf("expected channel to be closed, but received %+v", v)
+			x.t.Error(formatErrorMessage(x.description, msg))
+		}
+
+	case <-timer.C:
+		msg := fmt.Sprintf("expected channel to be closed within %v, but not closed", timeout)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// NotClosed checks if the channel is not closed at the moment. If a value is immediately available, the value is consumed.
+//
+//	ch := make(chan int)
+//	gt.Chan(t, ch).NotClosed() // Pass
+//	close(ch)
+//	gt.Chan(t, ch).NotClosed() // Fail
+func (x ChanTest[T]) NotClosed() ChanTest[T] {
+	x.t.Helper()
+
+	select {
+	case _, ok := <-x.actual:
+		if !ok {
+			msg := "expected channel not to be closed, but closed"
+			x.t.Error(formatErrorMessage(x.description, msg))
+		}
+
+	default:
+	}
+
+	return x
+}
+
+// Empty checks if the channel has no buffered value. Empty does not receive any value.
+//
+//	ch := make(chan int, 1)
+//	gt.Chan(t, ch).Empty() // Pass
+//	ch <- 1
+//	gt.Chan(t, ch).Empty() // Fail
+func (x ChanTest[T]) Empty() ChanTest[T] {
+	x.t.Helper()
+
+	if n := len(x.actual); n != 0 {
+		msg := fmt.Sprintf("expected channel to be empty, but has %d buffered values", n)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Length checks number of buffered values in the channel. Length does not receive any value.
+//
+//	ch := make(chan int, 3)
+//	ch <- 1
+//	gt.Chan(t, ch).Length(1) // Pass
+func (x ChanTest[T]) Length(expect int) ChanTest[T] {
+	x.t.Helper()
+
+	if n := len(x.actual); n != expect {
+		msg := fmt.Sprintf("channel length is expected to be %d, but actual is %d", expect, n)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// NoReceiveWithin checks if the channel neither provides a value nor is closed for duration d.
+//
+//	ch := make(chan int)
+//	gt.Chan(t, ch).NoReceiveWithin(100 * time.Millisecond) // Pass
+func (x ChanTest[T]) NoReceiveWithin(d time.Duration) ChanTest[T] {
+	x.t.Helper()
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case v, ok := <-x.actual:
+		if ok {
+			msg := fmt.Sprintf("expected no value within %v, but received %+v", d, v)
+			x.t.Error(formatErrorMessage(x.description, msg))
+		} else {
+			msg := fmt.Sprintf("expected no value within %v, but channel is closed", d)
+			x.t.Error(formatErrorMessage(x.description, msg))
+		}
+
+	case <-timer.C:
+	}
+
+	return x
+}
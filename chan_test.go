@@ -0,0 +1,160 @@
+package gt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestChan(t *testing.T) {
+	type testCase struct {
+		test func(t testing.TB)
+		pass bool
+	}
+
+	testCases := map[string]testCase{
+		"Receive: pass": {
+			test: func(t testing.TB) {
+				ch := make(chan int, 1)
+				ch <- 5
+				gt.Chan(t, ch).Receive(time.Second).Equal(5)
+			},
+			pass: true,
+		},
+		"Receive: fail by timeout": {
+			test: func(t testing.TB) {
+				ch := make(chan int)
+				gt.Chan(t, ch).Receive(time.Millisecond)
+			},
+			pass: false,
+		},
+		"Receive: fail by closed": {
+			test: func(t testing.TB) {
+				ch := make(chan int)
+				close(ch)
+				gt.Chan(t, ch).Receive(time.Second)
+			},
+			pass: false,
+		},
+		"ReceiveAll: pass": {
+			test: func(t testing.TB) {
+				ch := make(chan string)
+				go func() {
+					ch <- "a"
+					ch <- "b"
+					close(ch)
+				}()
+				gt.Chan(t, ch).ReceiveAll(time.Second).Equal([]string{"a", "b"})
+			},
+			pass: true,
+		},
+		"ReceiveAll: fail by not closed": {
+			test: func(t testing.TB) {
+				ch := make(chan string, 1)
+				ch <- "a"
+				gt.Chan(t, ch).ReceiveAll(10 * time.Millisecond)
+			},
+			pass: false,
+		},
+		"Drain: pass": {
+			test: func(t testing.TB) {
+				ch := make(chan int, 3)
+				ch <- 1
+				ch <- 2
+				gt.Chan(t, ch).Drain().Equal([]int{1, 2})
+				gt.Chan(t, ch).Empty()
+			},
+			pass: true,
+		},
+		"Closed: pass": {
+			test: func(t testing.TB) {
+				ch := make(chan int)
+				close(ch)
+				gt.Chan(t, ch).Closed(time.Second)
+			},
+			pass: true,
+		},
+		"Closed: fail by value": {
+			test: func(t testing.TB) {
+				ch := make(chan int, 1)
+				ch <- 1
+				gt.Chan(t, ch).Closed(time.Second)
+			},
+			pass: false,
+		},
+		"Closed: fail by timeout": {
+			test: func(t testing.TB) {
+				ch := make(chan int)
+				gt.Chan(t, ch).Closed(time.Millisecond)
+			},
+			pass: false,
+		},
+		"NotClosed: pass": {
+			test: func(t testing.TB) {
+				ch := make(chan int)
+				gt.Chan(t, ch).NotClosed()
+			},
+			pass: true,
+		},
+		"NotClosed: fail": {
+			test: func(t testing.TB) {
+				ch := make(chan int)
+				close(ch)
+				gt.Chan(t, ch).NotClosed()
+			},
+			pass: false,
+		},
+		"Empty: fail": {
+			test: func(t testing.TB) {
+				ch := make(chan int, 1)
+				ch <- 1
+				gt.Chan(t, ch).Empty()
+			},
+			pass: false,
+		},
+		"Length: pass": {
+			test: func(t testing.TB) {
+				ch := make(chan int, 3)
+				ch <- 1
+				ch <- 2
+				gt.Chan(t, ch).Length(2)
+			},
+			pass: true,
+		},
+		"NoReceiveWithin: pass": {
+			test: func(t testing.TB) {
+				ch := make(chan int)
+				gt.Chan(t, ch).NoReceiveWithin(5 * time.Millisecond)
+			},
+			pass: true,
+		},
+		"NoReceiveWithin: fail": {
+			test: func(t testing.TB) {
+				ch := make(chan int, 1)
+				ch <- 1
+				gt.Chan(t, ch).NoReceiveWithin(time.Second)
+			},
+			pass: false,
+		},
+		"receive only channel": {
+			test: func(t testing.TB) {
+				ch := make(chan int, 1)
+				ch <- 3
+				var recv <-chan int = ch
+				gt.Ch(t, recv).Receive(time.Second).Equal(3)
+			},
+			pass: true,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(r)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
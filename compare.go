@@ -0,0 +1,122 @@
+package gt
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type compareConfig struct {
+	options          []cmp.Option
+	ignoreUnexported bool
+}
+
+// CompareOption is an option for EqualWith methods. The same options are used for both comparison and diff output, then they never disagree.
+type CompareOption func(cfg *compareConfig)
+
+func newCompareConfig(options []CompareOption) *compareConfig {
+	cfg := &compareConfig{}
+	for _, opt := range options {
+		opt(cfg)
+	}
+	return cfg
+}
+
+func (x *compareConfig) cmpOptions() []cmp.Option {
+	opts := append([]cmp.Option{}, x.options...)
+	if !x.ignoreUnexported {
+		opts = append(opts, cmp.Exporter(func(t reflect.Type) bool { return true }))
+	}
+	return opts
+}
+
+func (x *compareConfig) equal(expect, actual any) bool {
+	return cmp.Equal(expect, actual, x.cmpOptions()...)
+}
+
+func (x *compareConfig) diff(expect, actual any) string {
+	return "diff:\n" + cmp.Diff(expect, actual, x.cmpOptions()...)
+}
+
+// IgnoreFields ignores struct fields that have one of given names in comparison. A name can be a field name (e.g. "UpdatedAt") that matches the field at any depth, or a dot-separated path (e.g. "Meta.UpdatedAt") that matches the trailing fields.
+//
+//	gt.Value(t, user).EqualWith(expected, gt.IgnoreFields("ID", "Meta.UpdatedAt"))
+func IgnoreFields(names ...string) CompareOption {
+	return func(cfg *compareConfig) {
+		cfg.options = append(cfg.options, cmp.FilterPath(func(p cmp.Path) bool {
+			var fields []string
+			for _, step := range p {
+				if sf, ok := step.(cmp.StructField); ok {
+					fields = append(fields, sf.Name())
+				}
+			}
+			if len(fields) == 0 {
+				return false
+			}
+			if _, ok := p.Last().(cmp.StructField); !ok {
+				return false
+			}
+
+			for _, name := range names {
+				parts := strings.Split(name, ".")
+				if len(parts) > len(fields) {
+					continue
+				}
+				if reflect.DeepEqual(parts, fields[len(fields)-len(parts):]) {
+					return true
+				}
+			}
+			return false
+		}, cmp.Ignore()))
+	}
+}
+
+// IgnoreUnexported ignores all unexported struct fields in comparison. By default, unexported fields are compared.
+//
+//	gt.Value(t, user).EqualWith(expected, gt.IgnoreUnexported())
+func IgnoreUnexported() CompareOption {
+	return func(cfg *compareConfig) {
+		cfg.ignoreUnexported = true
+		cfg.options = append(cfg.options, cmp.FilterPath(func(p cmp.Path) bool {
+			sf, ok := p.Last().(cmp.StructField)
+			if !ok {
+				return false
+			}
+			r, _ := utf8.DecodeRuneInString(sf.Name())
+			return !unicode.IsUpper(r)
+		}, cmp.Ignore()))
+	}
+}
+
+// SortSlices sorts slices by less before comparison. less must be a function like func(a, b T) bool, and T is element type of slices to be sorted.
+//
+//	gt.Value(t, []int{3, 1, 2}).EqualWith([]int{1, 2, 3}, gt.SortSlices(func(a, b int) bool {
+//		return a < b
+//	})) // Pass
+func SortSlices(less any) CompareOption {
+	return func(cfg *compareConfig) {
+		cfg.options = append(cfg.options, cmpopts.SortSlices(less))
+	}
+}
+
+// EquateApprox treats float32 and float64 values as equal if absolute difference between them is margin or less.
+//
+//	gt.Value(t, 0.1+0.2).EqualWith(0.3, gt.EquateApprox(0.001)) // Pass
+func EquateApprox(margin float64) CompareOption {
+	return func(cfg *compareConfig) {
+		cfg.options = append(cfg.options, cmpopts.EquateApprox(0, margin))
+	}
+}
+
+// EquateEmpty treats nil and empty slices or maps as equal.
+//
+//	gt.Value(t, []int{}).EqualWith(nil, gt.EquateEmpty()) // Pass
+func EquateEmpty() CompareOption {
+	return func(cfg *compareConfig) {
+		cfg.options = append(cfg.options, cmpopts.EquateEmpty())
+	}
+}
@@ -0,0 +1,113 @@
+package gt_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+)
+
+type compareMeta struct {
+	UpdatedAt time.Time
+	Version   int
+}
+
+type compareUser struct {
+	ID     int
+	Name   string
+	Tags   []string
+	Score  float64
+	Meta   compareMeta
+	secret string
+}
+
+func TestEqualWith(t *testing.T) {
+	base := compareUser{
+		ID:     1,
+		Name:   "blue",
+		Tags:   []string{"b", "a"},
+		Score:  0.1 + 0.2,
+		Meta:   compareMeta{UpdatedAt: time.Now(), Version: 3},
+		secret: "x",
+	}
+
+	testCases := map[string]struct {
+		expect  compareUser
+		options []gt.CompareOption
+		pass    bool
+	}{
+		"fail without options": {
+			expect: compareUser{ID: 1, Name: "blue", Tags: []string{"a", "b"}, Score: 0.3, Meta: compareMeta{Version: 3}, secret: "y"},
+			pass:   false,
+		},
+		"pass with all options": {
+			expect: compareUser{ID: 1, Name: "blue", Tags: []string{"a", "b"}, Score: 0.3, Meta: compareMeta{Version: 3}, secret: "y"},
+			options: []gt.CompareOption{
+				gt.IgnoreFields("UpdatedAt"),
+				gt.IgnoreUnexported(),
+				gt.SortSlices(func(a, b string) bool { return a < b }),
+				gt.EquateApprox(0.001),
+			},
+			pass: true,
+		},
+		"pass with dotted field path": {
+			expect: compareUser{ID: 1, Name: "blue", Tags: []string{"b", "a"}, Score: 0.1 + 0.2, Meta: compareMeta{Version: 3}, secret: "x"},
+			options: []gt.CompareOption{
+				gt.IgnoreFields("Meta.UpdatedAt"),
+			},
+			pass: true,
+		},
+		"fail with unmatched dotted field path": {
+			expect: compareUser{ID: 1, Name: "blue", Tags: []string{"b", "a"}, Score: 0.1 + 0.2, Meta: compareMeta{Version: 3}, secret: "x"},
+			options: []gt.CompareOption{
+				gt.IgnoreFields("Other.UpdatedAt"),
+			},
+			pass: false,
+		},
+		"fail by unexported field": {
+			expect: compareUser{ID: 1, Name: "blue", Tags: []string{"b", "a"}, Score: 0.1 + 0.2, Meta: compareMeta{Version: 3}, secret: "y"},
+			options: []gt.CompareOption{
+				gt.IgnoreFields("UpdatedAt"),
+			},
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			gt.Value(r, base).EqualWith(tc.expect, tc.options...)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestEqualWithDiff(t *testing.T) {
+	r := newRecorder()
+	gt.Value(r, compareUser{ID: 1, Name: "blue", Meta: compareMeta{UpdatedAt: time.Now()}}).
+		EqualWith(compareUser{ID: 1, Name: "orange"}, gt.IgnoreFields("UpdatedAt"))
+
+	if r.errs != 1 {
+		t.Fatal("should fail")
+	}
+	if !strings.Contains(r.msgs[0], "Name") || strings.Contains(r.msgs[0], "UpdatedAt") {
+		t.Errorf("diff should show only Name: %s", r.msgs[0])
+	}
+}
+
+func TestEqualWithEmpty(t *testing.T) {
+	r := newRecorder()
+	gt.Array(r, []int{}).EqualWith(nil, gt.EquateEmpty())
+	gt.Map(r, map[string][]int{"a": {}}).EqualWith(map[string][]int{"a": nil}, gt.EquateEmpty())
+	if r.errs != 0 {
+		t.Errorf("should pass, but %v", r.msgs)
+	}
+
+	gt.Array(r, []int{}).EqualWith(nil)
+	if r.errs != 1 {
+		t.Error("should fail without EquateEmpty")
+	}
+}
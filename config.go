@@ -0,0 +1,167 @@
+package gt
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// Config customizes evaluation functions for a test. A nil field falls back to the package level variable (EvalCompare, EvalIsNil, EvalFileExists, Diff and DumpError).
+type Config struct {
+	// Compare is used instead of EvalCompare
+	Compare func(a, b any) bool
+	// IsNil is used instead of EvalIsNil
+	IsNil func(v any) bool
+	// FileExists is used instead of EvalFileExists
+	FileExists func(path string) bool
+	// Diff is used instead of Diff
+	Diff func(expect, actual any) string
+	// DumpError is used instead of DumpError
+	DumpError func(err error) string
+	// ErrorExtractors are used in addition to ErrorExtractors (prior to them)
+	ErrorExtractors []ErrorExtractor
+}
+
+// configs is a map of testing.TB and *Config
+var configs sync.Map
+
+// namedConfigs is a map of test name and *Config. It is used to inherit configuration of parent test in subtests.
+var namedConfigs sync.Map
+
+// configCount is number of registered configurations. Lookup is skipped while no configuration is registered.
+var configCount int64
+
+// Configure sets cfg to t. All assertions with t and subtests of t (created by t.Run) use evaluation functions in cfg instead of package level variables. A subtest can override it by calling Configure again. Unlike replacing package level variables, Configure does not affect other tests and it is safe with t.Parallel(). The configuration is removed by t.Cleanup when the test finished.
+//
+//	gt.Configure(t, gt.Config{
+//		Compare: func(a, b any) bool {
+//			return cmp.Equal(a, b, cmpopts.EquateEmpty())
+//		},
+//	})
+//	gt.Value(t, []int{}).Equal(nil) // Pass
+//
+// To configure all tests in a package, call Configure in a helper used by the tests, or replace package level variables in TestMain.
+func Configure(t testing.TB, cfg Config) {
+	t.Helper()
+	if !isComparableTB(t) {
+		t.Errorf("gt.Configure does not support testing.TB of %T because it is not comparable", t)
+		return
+	}
+
+	name, named := testName(t)
+	if named {
+		namedConfigs.Store(name, &cfg)
+	}
+	if _, loaded := configs.LoadOrStore(t, &cfg); loaded {
+		configs.Store(t, &cfg)
+		return
+	}
+
+	atomic.AddInt64(&configCount, 1)
+	t.Cleanup(func() {
+		configs.Delete(t)
+		if named {
+			namedConfigs.Delete(name)
+		}
+		atomic.AddInt64(&configCount, -1)
+	})
+}
+
+// tbUnwrapper is implemented by testing.TB wrappers in gt to look up configuration of original testing.TB.
+type tbUnwrapper interface {
+	unwrapTB() testing.TB
+}
+
+func lookupConfig(t testing.TB) *Config {
+	if atomic.LoadInt64(&configCount) == 0 {
+		return nil
+	}
+
+	for t != nil {
+		if isComparableTB(t) {
+			if v, ok := configs.Load(t); ok {
+				return v.(*Config)
+			}
+		}
+		w, ok := t.(tbUnwrapper)
+		if !ok {
+			break
+		}
+		t = w.unwrapTB()
+	}
+
+	// Look up configuration of parent tests by test name, e.g. "TestA/sub/case" -> "TestA/sub" -> "TestA"
+	if name, ok := testName(t); ok {
+		for {
+			if v, ok := namedConfigs.Load(name); ok {
+				return v.(*Config)
+			}
+			idx := strings.LastIndex(name, "/")
+			if idx < 0 {
+				break
+			}
+			name = name[:idx]
+		}
+	}
+
+	return nil
+}
+
+// isComparableTB returns true if t can be used as a key of sync.Map. A testing.TB wrapper of struct with non comparable field (e.g. slice) can not be.
+func isComparableTB(t testing.TB) bool {
+	return reflect.TypeOf(t).Comparable()
+}
+
+// testName returns name of t if t is testing.T or testing.B of testing package. Names of other testing.TB implementations are not used because they may not be unique.
+func testName(t testing.TB) (string, bool) {
+	switch v := t.(type) {
+	case *testing.T:
+		return v.Name(), true
+	case *testing.B:
+		return v.Name(), true
+	default:
+		return "", false
+	}
+}
+
+func evalCompare(t testing.TB, a, b any) bool {
+	if cfg := lookupConfig(t); cfg != nil && cfg.Compare != nil {
+		return cfg.Compare(a, b)
+	}
+	return EvalCompare(a, b)
+}
+
+func evalIsNil(t testing.TB, v any) bool {
+	if cfg := lookupConfig(t); cfg != nil && cfg.IsNil != nil {
+		return cfg.IsNil(v)
+	}
+	return EvalIsNil(v)
+}
+
+func evalFileExists(t testing.TB, path string) bool {
+	if cfg := lookupConfig(t); cfg != nil && cfg.FileExists != nil {
+		return cfg.FileExists(path)
+	}
+	return EvalFileExists(path)
+}
+
+func evalDiff(t testing.TB, expect, actual any) string {
+	if cfg := lookupConfig(t); cfg != nil && cfg.Diff != nil {
+		return cfg.Diff(expect, actual)
+	}
+	return Diff(expect, actual)
+}
+
+func dumpError(t testing.TB, err error) string {
+	if cfg := lookupConfig(t); cfg != nil {
+		if cfg.DumpError != nil {
+			return cfg.DumpError(err)
+		}
+		if len(cfg.ErrorExtractors) > 0 {
+			return dumpErrorDetail(err, errorExtractors(t))
+		}
+	}
+	return DumpError(err)
+}
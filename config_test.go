@@ -0,0 +1,158 @@
+package gt_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestConfigure(t *testing.T) {
+	t.Run("Compare is used only for configured test", func(t *testing.T) {
+		r := newRecorder()
+		gt.Configure(r, gt.Config{
+			Compare: func(a, b any) bool { return true },
+		})
+		gt.Value(r, 1).Equal(2)
+		gt.Array(r, []int{1}).Has(2)
+		gt.Equal(r, "a", "b")
+		if r.errs != 0 {
+			t.Errorf("should pass with configured Compare, but %v", r.msgs)
+		}
+
+		other := newRecorder()
+		gt.Value(other, 1).Equal(2)
+		if other.errs != 1 {
+			t.Error("other test should not be affected")
+		}
+	})
+
+	t.Run("configuration is removed by cleanup", func(t *testing.T) {
+		r := newRecorder()
+		gt.Configure(r, gt.Config{
+			Compare: func(a, b any) bool { return true },
+		})
+		r.runCleanups()
+
+		gt.Value(r, 1).Equal(2)
+		if r.errs != 1 {
+			t.Error("should fail after cleanup")
+		}
+	})
+
+	t.Run("IsNil, FileExists and Diff", func(t *testing.T) {
+		r := newRecorder()
+		gt.Configure(r, gt.Config{
+			IsNil:      func(v any) bool { return v == 0 },
+			FileExists: func(path string) bool { return path == "virtual.txt" },
+			Diff:       func(expect, actual any) string { return "custom diff" },
+		})
+		gt.Value(r, 0).Nil()
+		gt.File(r, "virtual.txt").Exists()
+		if r.errs != 0 {
+			t.Errorf("should pass, but %v", r.msgs)
+		}
+
+		gt.Value(r, "a").Equal("b")
+		if r.errs != 1 || !strings.Contains(r.msgs[0], "custom diff") {
+			t.Errorf("custom diff should be used, but %v", r.msgs)
+		}
+	})
+
+	t.Run("DumpError", func(t *testing.T) {
+		r := newRecorder()
+		gt.Configure(r, gt.Config{
+			DumpError: func(err error) string { return "dumped: " + err.Error() },
+		})
+		gt.Return1("", errors.New("boom")).NoError(r)
+		if r.errs != 1 || !strings.Contains(r.msgs[0], "dumped: boom") {
+			t.Errorf("custom DumpError should be used, but %v", r.msgs)
+		}
+	})
+
+	t.Run("configuration is available in Eventually block", func(t *testing.T) {
+		r := newRecorder()
+		gt.Configure(r, gt.Config{
+			Compare: func(a, b any) bool { return true },
+		})
+		gt.Eventually(r, func(t testing.TB) {
+			gt.Value(t, 1).Equal(2)
+		})
+		if r.errs != 0 {
+			t.Errorf("should pass, but %v", r.msgs)
+		}
+	})
+
+	t.Run("parallel tests", func(t *testing.T) {
+		for i := 0; i < 4; i++ {
+			configured := i%2 == 0
+			t.Run("", func(t *testing.T) {
+				t.Parallel()
+				r := newRecorder()
+				if configured {
+					gt.Configure(r, gt.Config{
+						Compare: func(a, b any) bool { return true },
+					})
+				}
+				gt.Value(r, 1).Equal(2)
+				if configured != (r.errs == 0) {
+					t.Errorf("unexpected result: %v", r.msgs)
+				}
+			})
+		}
+	})
+}
+
+type unhashableTB struct {
+	testing.TB
+	tags []string
+}
+
+func TestConfigureUnhashableTB(t *testing.T) {
+	// Register a configuration for another test to make lookup active
+	other := newRecorder()
+	gt.Configure(other, gt.Config{})
+	defer other.runCleanups()
+
+	t.Run("assertions work", func(t *testing.T) {
+		tb := unhashableTB{TB: t, tags: []string{"a"}}
+		gt.Value(tb, 1).Equal(1)
+		gt.Array(tb, []int{1}).Has(1)
+	})
+
+	t.Run("Configure reports error", func(t *testing.T) {
+		r := newRecorder()
+		gt.Configure(unhashableTB{TB: r}, gt.Config{})
+		if r.errs != 1 || !strings.Contains(r.msgs[0], "not comparable") {
+			t.Errorf("unexpected result: %v", r.msgs)
+		}
+	})
+}
+
+func TestConfigureInheritance(t *testing.T) {
+	gt.Configure(t, gt.Config{
+		Compare: func(a, b any) bool { return true },
+	})
+
+	t.Run("subtest inherits", func(t *testing.T) {
+		gt.Value(t, 1).Equal(2)
+
+		t.Run("nested subtest inherits", func(t *testing.T) {
+			t.Parallel()
+			gt.Value(t, 1).Equal(2)
+		})
+	})
+
+	t.Run("subtest overrides", func(t *testing.T) {
+		gt.Configure(t, gt.Config{
+			Compare: func(a, b any) bool { return a == b },
+		})
+		gt.Value(t, 1).Equal(1)
+		gt.Value(t, 1).NotEqual(2)
+
+		t.Run("nested subtest uses override", func(t *testing.T) {
+			gt.Value(t, 1).NotEqual(2)
+		})
+	})
+}
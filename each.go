@@ -0,0 +1,63 @@
+package gt
+
+import (
+	"fmt"
+	"testing"
+)
+
+type eachConfig struct {
+	subtest bool
+}
+
+// EachOption is an option for Each of ArrayTest and MapTest.
+type EachOption func(cfg *eachConfig)
+
+// EachSubtest runs f for each element as a subtest by t.Run. The subtest is named by index or key. If testing.TB of the test does not have Run(string, func(*testing.T)) bool like *testing.T, f is run in the default manner.
+func EachSubtest() EachOption {
+	return func(cfg *eachConfig) {
+		cfg.subtest = true
+	}
+}
+
+func newEachConfig(options []EachOption) *eachConfig {
+	cfg := &eachConfig{}
+	for _, opt := range options {
+		opt(cfg)
+	}
+	return cfg
+}
+
+type subtestRunner interface {
+	Run(name string, f func(t *testing.T)) bool
+}
+
+// runEach calls f for an element labeled by label. In default, failures in f are recorded and reported to x.t with the label prefix, and t.FailNow() in f stops only f. With EachSubtest, f is called in a subtest named by label.
+func (x TestMeta) runEach(cfg *eachConfig, label string, f func(t testing.TB)) {
+	x.t.Helper()
+
+	if cfg.subtest {
+		if runner, ok := x.t.(subtestRunner); ok {
+			runner.Run(label, func(t *testing.T) {
+				f(t)
+			})
+			return
+		}
+	}
+
+	r := runRecorded(x.t, f)
+	r.replayLogs(x.t)
+	if !r.Failed() {
+		if r.Skipped() {
+			x.t.Logf("[%s] skipped", label)
+		}
+		return
+	}
+
+	msgs := r.errors()
+	if len(msgs) == 0 {
+		msgs = []string{"failed without message"}
+	}
+	for _, msg := range msgs {
+		x.t.Error(formatErrorMessage(x.description, fmt.Sprintf("[%s] %s", label, msg)))
+	}
+}
@@ -0,0 +1,104 @@
+package gt_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestArrayEach(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		r := newRecorder()
+		var called []int
+		gt.Array(r, []int{1, 2, 3}).Each(func(t testing.TB, i int, v int) {
+			called = append(called, i)
+			gt.Number(t, v).Positive()
+		})
+		gt.Number(t, r.errs).Equal(0)
+		gt.Array(t, called).Equal([]int{0, 1, 2})
+	})
+
+	t.Run("failures are prefixed with index", func(t *testing.T) {
+		r := newRecorder()
+		gt.Array(r, []int{1, -2, 3, -4}).Describe("all positive").Each(func(t testing.TB, i int, v int) {
+			gt.Number(t, v).Positive()
+		})
+		gt.Number(t, r.errs).Equal(2)
+		gt.String(t, r.msgs[0]).HasPrefix("all positive").Contains("[1] got -2")
+		gt.String(t, r.msgs[1]).Contains("[3] got -4")
+	})
+
+	t.Run("Required stops only the element", func(t *testing.T) {
+		r := newRecorder()
+		var called int
+		gt.Array(r, []string{"a", "", "c"}).Each(func(t testing.TB, i int, v string) {
+			gt.String(t, v).IsNotEmpty().Required()
+			called++
+		})
+		gt.Number(t, r.errs).Equal(1)
+		gt.Number(t, called).Equal(2)
+	})
+
+	t.Run("Skip stops only the element", func(t *testing.T) {
+		r := newRecorder()
+		var called int
+		gt.Array(r, []string{"a", "", "c"}).Each(func(t testing.TB, i int, v string) {
+			if v == "" {
+				t.Skip("empty")
+			}
+			called++
+		})
+		gt.Number(t, r.errs).Equal(0)
+		gt.Number(t, called).Equal(2)
+	})
+
+	t.Run("subtest", func(t *testing.T) {
+		var names []string
+		gt.Array(t, []int{1, 2}).Each(func(t testing.TB, i int, v int) {
+			names = append(names, t.Name())
+			gt.Number(t, v).Positive()
+		}, gt.EachSubtest())
+		gt.Array(t, names).Length(2).All(func(name string) bool {
+			return strings.HasPrefix(name, "TestArrayEach/subtest/")
+		})
+	})
+
+	t.Run("subtest falls back without Run", func(t *testing.T) {
+		r := newRecorder()
+		gt.Array(r, []int{1, -2}).Each(func(t testing.TB, i int, v int) {
+			gt.Number(t, v).Positive()
+		}, gt.EachSubtest())
+		gt.Number(t, r.errs).Equal(1)
+		gt.String(t, r.msgs[0]).Contains("[1] ")
+	})
+}
+
+func TestMapEach(t *testing.T) {
+	t.Run("failures are prefixed with key in order", func(t *testing.T) {
+		r := newRecorder()
+		m := map[string]int{"red": -1, "blue": 5, "green": -3}
+		gt.Map(r, m).Each(func(t testing.TB, k string, v int) {
+			gt.Number(t, v).Describe(k).Positive()
+		})
+		gt.Number(t, r.errs).Equal(2)
+		gt.String(t, r.msgs[0]).HasPrefix("[green] green")
+		gt.String(t, r.msgs[1]).HasPrefix("[red] red")
+	})
+
+	t.Run("subtest", func(t *testing.T) {
+		var names []string
+		gt.Map(t, map[string]int{"a": 1, "b": 2}).Each(func(t testing.TB, k string, v int) {
+			names = append(names, t.Name())
+		}, gt.EachSubtest())
+		gt.Array(t, names).Equal([]string{"TestMapEach/subtest/a", "TestMapEach/subtest/b"})
+	})
+
+	t.Run("int keys in numeric order", func(t *testing.T) {
+		var keys []int
+		gt.Map(t, map[int]bool{10: true, 2: true, 1: true}).Each(func(t testing.TB, k int, v bool) {
+			keys = append(keys, k)
+		})
+		gt.Array(t, keys).Equal([]int{1, 2, 10})
+	})
+}
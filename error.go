@@ -3,6 +3,7 @@ package gt
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -43,21 +44,23 @@ func (x ErrorTest) Required() ErrorTest {
 }
 
 // Is checks error object equality by errors.Is() function.
-func (x ErrorTest) Is(expected error) {
+func (x ErrorTest) Is(expected error) ErrorTest {
 	x.t.Helper()
 	if x.actual != nil && !errors.Is(x.actual, expected) {
 		msg := fmt.Sprintf("expected %T, but not got from %T", expected, x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
+	return x
 }
 
 // IsNot checks error object not-equality by errors.Is() function.
-func (x ErrorTest) IsNot(expected error) {
+func (x ErrorTest) IsNot(expected error) ErrorTest {
 	x.t.Helper()
 	if x.actual != nil && errors.Is(x.actual, expected) {
 		msg := fmt.Sprintf("not expected %T, but got from %T", expected, x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
+	return x
 }
 
 // ErrorAs checks error type by errors.As() function. If type check passed, callback will be invoked and given extracted error by errors.As.
@@ -80,7 +83,7 @@ type NoErrorTest struct {
 func NoError(t testing.TB, actual error) NoErrorTest {
 	t.Helper()
 	if actual != nil {
-		t.Errorf("expected no error, but got %+v", actual)
+		t.Errorf("expected no error, but got %s", dumpError(t, actual))
 	}
 	return NoErrorTest{
 		t:      t,
@@ -96,15 +99,164 @@ func (x NoErrorTest) Required() {
 }
 
 // Contains checks if the error message contains the expected substring.
-func (x ErrorTest) Contains(substr string) {
+func (x ErrorTest) Contains(substr string) ErrorTest {
 	x.t.Helper()
 	if x.actual == nil {
 		msg := fmt.Sprintf("expected error containing %q, but got no error", substr)
 		x.t.Error(formatErrorMessage(x.description, msg))
-		return
+		return x
 	}
 	if msg := x.actual.Error(); !strings.Contains(msg, substr) {
 		msgText := fmt.Sprintf("expected error message containing %q, but got %q", substr, msg)
 		x.t.Error(formatErrorMessage(x.description, msgText))
 	}
+	return x
+}
+
+// Equal checks if the error message equals expected.
+//
+//	gt.Error(t, errors.New("not found")).Equal("not found") // Pass
+func (x ErrorTest) Equal(expected string) ErrorTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+	if msg := x.actual.Error(); msg != expected {
+		msgText := fmt.Sprintf("error message is not matched\nactual: %s\nexpect: %s", msg, expected)
+		x.t.Error(formatErrorMessage(x.description, msgText))
+	}
+	return x
+}
+
+// HasPrefix checks if the error message has prefix.
+//
+//	gt.Error(t, errors.New("db: not found")).HasPrefix("db:") // Pass
+func (x ErrorTest) HasPrefix(prefix string) ErrorTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+	if msg := x.actual.Error(); !strings.HasPrefix(msg, prefix) {
+		msgText := fmt.Sprintf("expected error message with prefix %q, but got %q", prefix, msg)
+		x.t.Error(formatErrorMessage(x.description, msgText))
+	}
+	return x
+}
+
+// Match checks if the error message matches with regular expression pattern. If pattern is invalid, test will fail and stop.
+//
+//	gt.Error(t, errors.New("user 123 not found")).Match(`^user \d+ not found$`) // Pass
+func (x ErrorTest) Match(pattern string) ErrorTest {
+	x.t.Helper()
+	ptn, err := compilePattern(pattern)
+	if err != nil {
+		msg := fmt.Sprintf("invalid pattern, %+v: %v", pattern, err)
+		x.t.Error(formatErrorMessage(x.description, msg))
+		x.t.FailNow()
+		return x
+	}
+	if x.actual == nil {
+		return x
+	}
+	if msg := x.actual.Error(); !ptn.MatchString(msg) {
+		msgText := fmt.Sprintf("expected error message matching '%s', but got %q", pattern, msg)
+		x.t.Error(formatErrorMessage(x.description, msgText))
+	}
+	return x
+}
+
+// IsType checks if type of the error (not wrapped errors) is the same as type of expected.
+//
+//	gt.Error(t, &MyError{}).IsType(&MyError{})                           // Pass
+//	gt.Error(t, fmt.Errorf("wrap: %w", &MyError{})).IsType(&MyError{}) // Fail
+func (x ErrorTest) IsType(expected error) ErrorTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+	if reflect.TypeOf(x.actual) != reflect.TypeOf(expected) {
+		msg := fmt.Sprintf("expected error type %T, but got %T", expected, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// Unwrap provides ErrorTest of the error wrapped by actual error (by errors.Unwrap). If actual error does not wrap any error, test will fail. An error with Unwrap() []error (e.g. errors.Join) also fails because it has multiple wrapped errors; use Chain or Tree for it.
+//
+//	err := fmt.Errorf("query failed: %w", sql.ErrNoRows)
+//	gt.Error(t, err).Unwrap().Equal(sql.ErrNoRows.Error()) // Pass
+func (x ErrorTest) Unwrap() ErrorTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+
+	unwrapped := errors.Unwrap(x.actual)
+	if unwrapped == nil {
+		msg := fmt.Sprintf("expected wrapped error, but %T does not wrap error", x.actual)
+		if joined, ok := x.actual.(interface{ Unwrap() []error }); ok {
+			msg = fmt.Sprintf("expected single wrapped error, but %T is joined error that has %d children, use Chain() or Tree()", x.actual, len(joined.Unwrap()))
+		}
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return ErrorTest{
+		TestMeta: x.TestMeta,
+		actual:   unwrapped,
+	}
+}
+
+// Chain provides ArrayTest of all errors in the error tree of actual error, including actual error itself. Errors are listed in depth-first pre-order, following both Unwrap() error and Unwrap() []error (e.g. errors.Join).
+//
+//	err := fmt.Errorf("query failed: %w", sql.ErrNoRows)
+//	gt.Error(t, err).Chain().Length(2).Has(sql.ErrNoRows) // Pass
+func (x ErrorTest) Chain() ArrayTest[error] {
+	x.t.Helper()
+
+	var chain []error
+	walkErrorTree(x.actual, func(err error, depth int) {
+		chain = append(chain, err)
+	})
+
+	return ArrayTest[error]{
+		TestMeta: x.TestMeta,
+		actual:   chain,
+	}
+}
+
+// walkErrorTree calls f with each error in the tree of err in depth-first pre-order.
+func walkErrorTree(err error, f func(err error, depth int)) {
+	var walk func(err error, depth int)
+	walk = func(err error, depth int) {
+		if err == nil {
+			return
+		}
+		f(err, depth)
+
+		for _, child := range errorChildren(err) {
+			walk(child, depth+1)
+		}
+	}
+	walk(err, 0)
+}
+
+// As checks error type by errors.As() function in the same manner as ErrorAs, and calls f with testing.TB and extracted error. T must be a type implementing error or an interface type. It is a companion of ErrorTest methods for chaining.
+//
+//	gt.As(gt.Error(t, err).Contains("not found"), func(t testing.TB, e *NotFoundError) {
+//		gt.Value(t, e.ID).Equal(123)
+//	})
+func As[T any](x ErrorTest, f func(t testing.TB, v T)) ErrorTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+
+	var tgt T
+	if errors.As(x.actual, &tgt) {
+		f(x.t, tgt)
+	} else {
+		msg := fmt.Sprintf("expected %s, but got %T", reflect.TypeOf((*T)(nil)).Elem(), x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
 }
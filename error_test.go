@@ -3,6 +3,7 @@ package gt_test
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/m-mizutani/gt"
@@ -69,3 +70,123 @@ func TestErrorContains(t *testing.T) {
 		}
 	})
 }
+
+type joinedError []error
+
+func (x joinedError) Error() string {
+	var msgs []string
+	for _, err := range x {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (x joinedError) Unwrap() []error { return x }
+
+func TestErrorChaining(t *testing.T) {
+	errBase := errors.New("not found")
+	errOther := errors.New("other")
+	err := fmt.Errorf("query failed: %w", errBase)
+
+	testCases := map[string]struct {
+		test func(e gt.ErrorTest)
+		pass bool
+	}{
+		"chain pass": {
+			test: func(e gt.ErrorTest) {
+				e.Is(errBase).IsNot(errOther).Contains("query").HasPrefix("query failed:").
+					Equal("query failed: not found").Match(`^query \w+: not found$`).Required()
+			},
+			pass: true,
+		},
+		"Equal: fail": {
+			test: func(e gt.ErrorTest) { e.Equal("query failed") },
+			pass: false,
+		},
+		"HasPrefix: fail": {
+			test: func(e gt.ErrorTest) { e.HasPrefix("not found") },
+			pass: false,
+		},
+		"Match: fail": {
+			test: func(e gt.ErrorTest) { e.Match(`^not found`) },
+			pass: false,
+		},
+		"IsType: pass": {
+			test: func(e gt.ErrorTest) { e.IsType(fmt.Errorf("x: %w", errOther)) },
+			pass: true,
+		},
+		"IsType: fail": {
+			test: func(e gt.ErrorTest) { e.IsType(testError{}) },
+			pass: false,
+		},
+		"Unwrap: pass": {
+			test: func(e gt.ErrorTest) { e.Unwrap().Equal("not found").IsType(errBase) },
+			pass: true,
+		},
+		"Unwrap: fail by no wrapped error": {
+			test: func(e gt.ErrorTest) { e.Unwrap().Unwrap() },
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.Error(r, err))
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestErrorUnwrapJoined(t *testing.T) {
+	r := newRecorder()
+	gt.Error(r, joinedError{errors.New("a"), errors.New("b")}).Unwrap()
+	if r.errs != 1 || !strings.Contains(r.msgs[0], "joined error that has 2 children, use Chain() or Tree()") {
+		t.Errorf("unexpected result: %v", r.msgs)
+	}
+}
+
+func TestErrorMatchInvalidPattern(t *testing.T) {
+	r := newRecorder()
+	gt.Error(r, errors.New("x")).Match(`(`)
+	if r.errs != 1 || r.fails != 1 {
+		t.Error("invalid pattern should fail and stop")
+	}
+}
+
+func TestErrorChain(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	errC := testError{N: 3}
+	err := fmt.Errorf("top: %w", joinedError{errA, fmt.Errorf("wrap: %w", errB), errC})
+
+	gt.Error(t, err).Chain().
+		Length(6).
+		Has(errA).
+		Has(errB).
+		EqualAt(0, err).
+		EqualAt(5, error(errC))
+}
+
+func TestErrorTestAs(t *testing.T) {
+	err := fmt.Errorf("run error: %w", testError{N: 5})
+
+	var called int
+	gt.As(gt.Error(t, err).Contains("run error"), func(t testing.TB, e testError) {
+		called++
+		gt.Value(t, e.N).Equal(5)
+	}).Is(testError{N: 5})
+	if called != 1 {
+		t.Errorf("callback must be called once, but %+v times", called)
+	}
+
+	r := newRecorder()
+	gt.As(gt.Error(r, errors.New("x")), func(t testing.TB, e testError) {
+		t.Error("should not be called")
+	})
+	if r.errs != 1 || !strings.Contains(r.msgs[0], "gt_test.testError") {
+		t.Errorf("unexpected result: %v", r.msgs)
+	}
+}
@@ -0,0 +1,185 @@
+package gt
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type ErrorTreeTest struct {
+	TestMeta
+	actual error
+}
+
+// ErrorTree provides ErrorTreeTest that inspects shape of the error tree of actual. The tree is built by following both Unwrap() error and Unwrap() []error (e.g. errors.Join). A leaf is an error that wraps no error. If actual is nil, test will fail.
+//
+//	err := errors.Join(ErrNotFound, fmt.Errorf("db: %w", ErrTimeout))
+//	gt.ErrorTree(t, err).
+//		LeafCount(2).
+//		HasOnce(ErrNotFound, ErrTimeout)
+func ErrorTree(t testing.TB, actual error) ErrorTreeTest {
+	t.Helper()
+	if actual == nil {
+		t.Errorf("expected error, but got no error")
+	}
+	return ErrorTreeTest{
+		TestMeta: TestMeta{t: t},
+		actual:   actual,
+	}
+}
+
+// Tree provides ErrorTreeTest of actual error.
+//
+//	gt.Error(t, err).Tree().LeafCount(3)
+func (x ErrorTest) Tree() ErrorTreeTest {
+	return ErrorTreeTest{
+		TestMeta: x.TestMeta,
+		actual:   x.actual,
+	}
+}
+
+// Describe sets a description for the test. The description will be displayed when the test fails.
+func (x ErrorTreeTest) Describe(description string) ErrorTreeTest {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when the test fails.
+func (x ErrorTreeTest) Describef(format string, args ...any) ErrorTreeTest {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test. If errors has been occurred in previous test, it immediately stop test by t.FailNow().
+func (x ErrorTreeTest) Required() ErrorTreeTest {
+	x.requiredWithMeta()
+	return x
+}
+
+// LeafCount checks number of leaf errors in the tree.
+//
+//	err := errors.Join(errA, errors.Join(errB, errC))
+//	gt.ErrorTree(t, err).LeafCount(3) // Pass
+func (x ErrorTreeTest) LeafCount(expect int) ErrorTreeTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+
+	if leaves := errorLeaves(x.actual); len(leaves) != expect {
+		msg := fmt.Sprintf("number of leaf errors is expected to be %d, but actual is %d\n%s", expect, len(leaves), renderErrorTree(x.actual))
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// HasOnce checks if each of expected errors appears exactly once in the tree. An error in the tree matches if it equals expected or its Is(error) method reports true. Unlike errors.Is, matching does not follow wrapped errors of each node, then the same sentinel error wrapped twice is counted as twice.
+//
+//	err := errors.Join(ErrNotFound, fmt.Errorf("db: %w", ErrTimeout))
+//	gt.ErrorTree(t, err).HasOnce(ErrNotFound, ErrTimeout) // Pass
+func (x ErrorTreeTest) HasOnce(expected ...error) ErrorTreeTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+
+	var problems []string
+	for _, target := range expected {
+		if target == nil {
+			problems = append(problems, "nil is expected to appear once, but nil error can not be in the tree")
+			continue
+		}
+		count := 0
+		walkErrorTree(x.actual, func(err error, depth int) {
+			if isSameError(err, target) {
+				count++
+			}
+		})
+		if count != 1 {
+			problems = append(problems, fmt.Sprintf("%q (%T) is expected to appear once, but appeared %d times", target.Error(), target, count))
+		}
+	}
+
+	if len(problems) > 0 {
+		msg := strings.Join(problems, "\n") + "\n" + renderErrorTree(x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// EachLeaf calls f with testing.TB, index and ErrorTest of each leaf error in the tree. Leaves are listed in depth-first order.
+//
+//	gt.ErrorTree(t, err).EachLeaf(func(t testing.TB, i int, e gt.ErrorTest) {
+//		e.HasPrefix("validation:")
+//	})
+func (x ErrorTreeTest) EachLeaf(f func(t testing.TB, i int, e ErrorTest)) ErrorTreeTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+
+	for i, leaf := range errorLeaves(x.actual) {
+		f(x.t, i, ErrorTest{
+			TestMeta: x.TestMeta,
+			actual:   leaf,
+		})
+	}
+	return x
+}
+
+func isSameError(err, target error) bool {
+	if reflect.TypeOf(err) == reflect.TypeOf(target) && reflect.TypeOf(target).Comparable() && equalError(err, target) {
+		return true
+	}
+	if x, ok := err.(interface{ Is(error) bool }); ok && x.Is(target) {
+		return true
+	}
+	return false
+}
+
+// equalError compares err and target by ==. A comparable struct type can still hold a non-comparable value in its interface field, and then == panics. It is treated as not equal.
+func equalError(err, target error) (equal bool) {
+	defer func() {
+		if recover() != nil {
+			equal = false
+		}
+	}()
+	return err == target
+}
+
+func errorChildren(err error) []error {
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if child := e.Unwrap(); child != nil {
+			return []error{child}
+		}
+	case interface{ Unwrap() []error }:
+		return e.Unwrap()
+	}
+	return nil
+}
+
+func errorLeaves(err error) []error {
+	var leaves []error
+	walkErrorTree(err, func(e error, depth int) {
+		if len(errorChildren(e)) == 0 {
+			leaves = append(leaves, e)
+		}
+	})
+	return leaves
+}
+
+// renderErrorTree renders the error tree with indentation. Only the first line of each error message is shown.
+func renderErrorTree(err error) string {
+	var b strings.Builder
+	b.WriteString("error tree:")
+	walkErrorTree(err, func(e error, depth int) {
+		msg, _, _ := strings.Cut(e.Error(), "\n")
+		if len(errorChildren(e)) > 1 {
+			msg = "(joined)"
+		}
+		fmt.Fprintf(&b, "\n%s- %s (%T)", strings.Repeat("  ", depth+1), msg, e)
+	})
+	return b.String()
+}
@@ -0,0 +1,121 @@
+package gt_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestErrorTree(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	errC := errors.New("c")
+	err := fmt.Errorf("top: %w", joinedError{errA, fmt.Errorf("wrap: %w", errB)})
+
+	testCases := map[string]struct {
+		test func(x gt.ErrorTreeTest)
+		pass bool
+		msg  string
+	}{
+		"LeafCount: pass": {
+			test: func(x gt.ErrorTreeTest) { x.LeafCount(2) },
+			pass: true,
+		},
+		"LeafCount: fail": {
+			test: func(x gt.ErrorTreeTest) { x.LeafCount(3) },
+			pass: false,
+			msg:  "expected to be 3, but actual is 2",
+		},
+		"HasOnce: pass": {
+			test: func(x gt.ErrorTreeTest) { x.HasOnce(errA, errB) },
+			pass: true,
+		},
+		"HasOnce: fail by missing": {
+			test: func(x gt.ErrorTreeTest) { x.HasOnce(errA, errC) },
+			pass: false,
+			msg:  `"c" (*errors.errorString) is expected to appear once, but appeared 0 times`,
+		},
+		"HasOnce: fail by nil": {
+			test: func(x gt.ErrorTreeTest) { x.HasOnce(errA, nil) },
+			pass: false,
+			msg:  "nil is expected to appear once",
+		},
+		"tree is rendered with indentation": {
+			test: func(x gt.ErrorTreeTest) { x.HasOnce(errC) },
+			pass: false,
+			msg:  "error tree:\n  - top: a (*fmt.wrapError)\n    - (joined) (gt_test.joinedError)\n      - a (*errors.errorString)\n      - wrap: b (*fmt.wrapError)\n        - b (*errors.errorString)",
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.ErrorTree(r, err))
+			if tc.pass != (r.errs == 0) {
+				t.Fatalf("unexpected result: %v", r.msgs)
+			}
+			if tc.msg != "" && !strings.Contains(r.msgs[0], tc.msg) {
+				t.Errorf("message should contain %q, but %q", tc.msg, r.msgs[0])
+			}
+		})
+	}
+}
+
+func TestErrorTreeHasOnceDuplicated(t *testing.T) {
+	errA := errors.New("a")
+	r := newRecorder()
+	gt.Error(r, joinedError{errA, fmt.Errorf("again: %w", errA)}).Tree().HasOnce(errA)
+	if r.errs != 1 || !strings.Contains(r.msgs[0], "appeared 2 times") {
+		t.Errorf("unexpected result: %v", r.msgs)
+	}
+}
+
+type detailError struct {
+	detail any
+}
+
+func (x detailError) Error() string { return fmt.Sprintf("detail: %v", x.detail) }
+
+func TestErrorTreeHasOnceNonComparable(t *testing.T) {
+	errSlice := detailError{detail: []string{"a"}}
+	errStr := detailError{detail: "a"}
+	r := newRecorder()
+	gt.ErrorTree(r, joinedError{errSlice, errStr}).HasOnce(errStr)
+	if r.errs != 0 {
+		t.Errorf("should pass, but %v", r.msgs)
+	}
+
+	r = newRecorder()
+	gt.ErrorTree(r, joinedError{errSlice}).HasOnce(detailError{detail: []string{"a"}})
+	if r.errs != 1 || !strings.Contains(r.msgs[0], "appeared 0 times") {
+		t.Errorf("non-comparable error should not match: %v", r.msgs)
+	}
+}
+
+func TestErrorTreeEachLeaf(t *testing.T) {
+	err := joinedError{errors.New("validation: name"), joinedError{errors.New("validation: age")}}
+
+	var count int
+	r := newRecorder()
+	gt.ErrorTree(r, err).EachLeaf(func(t testing.TB, i int, e gt.ErrorTest) {
+		count++
+		e.HasPrefix("validation:").Contains("name")
+	})
+	if count != 2 {
+		t.Errorf("callback should be called twice, but %d", count)
+	}
+	if r.errs != 1 {
+		t.Errorf("only second leaf should fail, but %v", r.msgs)
+	}
+}
+
+func TestErrorTreeNil(t *testing.T) {
+	r := newRecorder()
+	gt.ErrorTree(r, nil).LeafCount(0)
+	if r.errs != 1 {
+		t.Error("should fail with nil error")
+	}
+}
@@ -0,0 +1,170 @@
+package gt
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// ErrorExtractor extracts structured information from an error. Extract is called for each error in the error tree (see ErrorTest.Chain), then an implementation should check only the given error and should not unwrap it. Extract returns nil values and empty stack if err has no information known by the extractor.
+type ErrorExtractor interface {
+	Extract(err error) (values map[string]any, stack string)
+}
+
+// ErrorExtractorFunc is a function type implementing ErrorExtractor.
+type ErrorExtractorFunc func(err error) (values map[string]any, stack string)
+
+// Extract calls f(err).
+func (f ErrorExtractorFunc) Extract(err error) (map[string]any, string) {
+	return f(err)
+}
+
+// ErrorExtractors is a list of ErrorExtractor used by ErrorTest.HasValue, ErrorTest.Values and default DumpError. A developer can append own extractor for custom error types. By default, it supports errors that have Values() map[string]any method (e.g. goerr), errors implementing slog.LogValuer (Go 1.21 or later), and errors that have StackTrace() or Stacks() method (e.g. pkg/errors, goerr).
+//
+//	gt.ErrorExtractors = append(gt.ErrorExtractors, gt.ErrorExtractorFunc(func(err error) (map[string]any, string) {
+//		if e, ok := err.(*MyError); ok {
+//			return map[string]any{"code": e.Code}, ""
+//		}
+//		return nil, ""
+//	}))
+var ErrorExtractors = []ErrorExtractor{
+	ErrorExtractorFunc(extractValuesMethod),
+	ErrorExtractorFunc(extractStackMethod),
+}
+
+func extractValuesMethod(err error) (map[string]any, string) {
+	if e, ok := err.(interface{ Values() map[string]any }); ok {
+		return e.Values(), ""
+	}
+	return nil, ""
+}
+
+func extractStackMethod(err error) (map[string]any, string) {
+	v := reflect.ValueOf(err)
+	for _, name := range []string{"StackTrace", "Stacks"} {
+		method := v.MethodByName(name)
+		if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+			continue
+		}
+		out := method.Call(nil)[0]
+		if out.Kind() == reflect.Slice {
+			if out.Len() == 0 {
+				continue
+			}
+			lines := make([]string, out.Len())
+			for i := range lines {
+				lines[i] = strings.TrimSpace(fmt.Sprintf("%+v", out.Index(i).Interface()))
+			}
+			return nil, strings.Join(lines, "\n")
+		}
+		if stack := strings.TrimSpace(fmt.Sprintf("%+v", out.Interface())); stack != "" {
+			return nil, stack
+		}
+	}
+	return nil, ""
+}
+
+// errorDetail has merged values and stack trace of the error tree.
+type errorDetail struct {
+	values map[string]any
+	stack  string
+}
+
+// extractErrorDetail calls extractors for each error in the tree of err. Values of outer error take precedence over inner error. Stack trace of the innermost error that has it is used because it is usually closest to the origin.
+func extractErrorDetail(err error, extractors []ErrorExtractor) errorDetail {
+	var chain []error
+	walkErrorTree(err, func(e error, depth int) {
+		chain = append(chain, e)
+	})
+
+	detail := errorDetail{values: map[string]any{}}
+	for i := len(chain) - 1; i >= 0; i-- {
+		for _, extractor := range extractors {
+			values, stack := extractor.Extract(chain[i])
+			for k, v := range values {
+				detail.values[k] = v
+			}
+			if stack != "" && detail.stack == "" {
+				detail.stack = stack
+			}
+		}
+	}
+
+	return detail
+}
+
+// dumpErrorDetail formats error message, values and stack trace of err.
+func dumpErrorDetail(err error, extractors []ErrorExtractor) string {
+	detail := extractErrorDetail(err, extractors)
+
+	var b strings.Builder
+	b.WriteString(err.Error())
+
+	if len(detail.values) > 0 {
+		keys := make([]string, 0, len(detail.values))
+		for k := range detail.values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteString("\nvalues:")
+		for _, k := range keys {
+			fmt.Fprintf(&b, "\n  %s: %+v", k, detail.values[k])
+		}
+	}
+
+	if detail.stack != "" {
+		b.WriteString("\nstack:\n  " + strings.ReplaceAll(detail.stack, "\n", "\n  "))
+	}
+
+	return b.String()
+}
+
+func errorExtractors(t testing.TB) []ErrorExtractor {
+	if cfg := lookupConfig(t); cfg != nil && len(cfg.ErrorExtractors) > 0 {
+		return append(append([]ErrorExtractor{}, cfg.ErrorExtractors...), ErrorExtractors...)
+	}
+	return ErrorExtractors
+}
+
+// HasValue checks if the error has an attribute of key and the value equals expected. Attributes are discovered by ErrorExtractors through the whole error tree. Default evaluation function uses reflect.DeepEqual, then type of expected must be the same with the attribute.
+//
+//	err := goerr.New("failed").With("user_id", 123)
+//	gt.Error(t, err).HasValue("user_id", 123) // Pass
+func (x ErrorTest) HasValue(key string, expected any) ErrorTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+
+	values := extractErrorDetail(x.actual, errorExtractors(x.t)).values
+	if v, ok := values[key]; !ok {
+		msg := fmt.Sprintf("error attribute '%s' is not found in %+v", key, values)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	} else if !evalCompare(x.t, v, expected) {
+		msg := fmt.Sprintf("error attribute '%s' is not matched\n", key) + evalDiff(x.t, expected, v)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// Values calls f with testing.TB and MapTest of all attributes of the error. Attributes are discovered by ErrorExtractors through the whole error tree.
+//
+//	gt.Error(t, err).Values(func(t testing.TB, m gt.MapTest[string, any]) {
+//		m.HasKey("request_id").EqualAt("status", 404)
+//	})
+func (x ErrorTest) Values(f func(t testing.TB, m MapTest[string, any])) ErrorTest {
+	x.t.Helper()
+	if x.actual == nil {
+		return x
+	}
+
+	values := extractErrorDetail(x.actual, errorExtractors(x.t)).values
+	f(x.t, MapTest[string, any]{
+		TestMeta: x.TestMeta,
+		actual:   values,
+	})
+	return x
+}
@@ -0,0 +1,36 @@
+//go:build go1.21
+
+package gt
+
+import "log/slog"
+
+func init() {
+	ErrorExtractors = append(ErrorExtractors, ErrorExtractorFunc(extractLogValuer))
+}
+
+// extractLogValuer extracts attributes from error implementing slog.LogValuer. Only group value is supported, and nested groups are converted to map[string]any.
+func extractLogValuer(err error) (map[string]any, string) {
+	lv, ok := err.(slog.LogValuer)
+	if !ok {
+		return nil, ""
+	}
+
+	v := lv.LogValue().Resolve()
+	if v.Kind() != slog.KindGroup {
+		return nil, ""
+	}
+	return slogGroupToMap(v.Group()), ""
+}
+
+func slogGroupToMap(attrs []slog.Attr) map[string]any {
+	m := make(map[string]any, len(attrs))
+	for _, attr := range attrs {
+		v := attr.Value.Resolve()
+		if v.Kind() == slog.KindGroup {
+			m[attr.Key] = slogGroupToMap(v.Group())
+		} else {
+			m[attr.Key] = v.Any()
+		}
+	}
+	return m
+}
@@ -0,0 +1,27 @@
+//go:build go1.21
+
+package gt_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+type logValuerError struct{}
+
+func (x logValuerError) Error() string { return "log valuer error" }
+
+func (x logValuerError) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("request_id", "req-1"),
+		slog.Group("user", slog.Int("id", 5)),
+	)
+}
+
+func TestErrorLogValuer(t *testing.T) {
+	gt.Error(t, logValuerError{}).
+		HasValue("request_id", "req-1").
+		HasValue("user", map[string]any{"id": int64(5)})
+}
@@ -0,0 +1,130 @@
+package gt_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+type valuesError struct {
+	msg    string
+	values map[string]any
+	cause  error
+}
+
+func (x *valuesError) Error() string {
+	if x.cause != nil {
+		return x.msg + ": " + x.cause.Error()
+	}
+	return x.msg
+}
+func (x *valuesError) Unwrap() error            { return x.cause }
+func (x *valuesError) Values() map[string]any   { return x.values }
+func (x *valuesError) StackTrace() []stackFrame { return []stackFrame{{"main.run"}, {"main.main"}} }
+
+type stackFrame struct{ fn string }
+
+func (x stackFrame) String() string { return "at " + x.fn }
+
+type codeError struct{ code int }
+
+func (x codeError) Error() string { return fmt.Sprintf("code %d", x.code) }
+
+func TestErrorHasValue(t *testing.T) {
+	inner := &valuesError{msg: "db error", values: map[string]any{"table": "users", "user_id": 1}}
+	err := fmt.Errorf("wrapped: %w", &valuesError{msg: "failed", values: map[string]any{"user_id": 2}, cause: inner})
+
+	testCases := map[string]struct {
+		test func(e gt.ErrorTest)
+		pass bool
+	}{
+		"pass with inner value": {
+			test: func(e gt.ErrorTest) { e.HasValue("table", "users") },
+			pass: true,
+		},
+		"outer value takes precedence": {
+			test: func(e gt.ErrorTest) { e.HasValue("user_id", 2) },
+			pass: true,
+		},
+		"fail with different value": {
+			test: func(e gt.ErrorTest) { e.HasValue("table", "groups") },
+			pass: false,
+		},
+		"fail with missing key": {
+			test: func(e gt.ErrorTest) { e.HasValue("request_id", "x") },
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.Error(r, err))
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestErrorValues(t *testing.T) {
+	err := &valuesError{msg: "failed", values: map[string]any{"user_id": 1, "table": "users"}}
+
+	var called int
+	gt.Error(t, err).Values(func(t testing.TB, m gt.MapTest[string, any]) {
+		called++
+		m.Length(2).HasKey("table").EqualAt("user_id", 1)
+	})
+	if called != 1 {
+		t.Errorf("callback should be called once, but %d", called)
+	}
+}
+
+func TestDumpErrorWithDetail(t *testing.T) {
+	err := &valuesError{msg: "failed", values: map[string]any{"user_id": 1, "table": "users"}}
+
+	r := newRecorder()
+	gt.NoError(r, err)
+	if r.errs != 1 {
+		t.Fatal("should fail")
+	}
+	expected := "failed\nvalues:\n  table: users\n  user_id: 1\nstack:\n  at main.run\n  at main.main"
+	if !strings.Contains(r.msgs[0], expected) {
+		t.Errorf("unexpected dump: %s", r.msgs[0])
+	}
+}
+
+func TestErrorExtractorsInConfig(t *testing.T) {
+	r := newRecorder()
+	gt.Configure(r, gt.Config{
+		ErrorExtractors: []gt.ErrorExtractor{
+			gt.ErrorExtractorFunc(func(err error) (map[string]any, string) {
+				var e codeError
+				if errors.As(err, &e) {
+					return map[string]any{"code": e.code}, ""
+				}
+				return nil, ""
+			}),
+		},
+	})
+
+	err := fmt.Errorf("request failed: %w", codeError{code: 404})
+	gt.Error(r, err).HasValue("code", 404)
+	if r.errs != 0 {
+		t.Errorf("should pass, but %v", r.msgs)
+	}
+
+	gt.Return1(0, err).NoError(r)
+	if r.errs != 1 || !strings.Contains(r.msgs[0], "code: 404") {
+		t.Errorf("extracted values should be dumped, but %v", r.msgs)
+	}
+
+	other := newRecorder()
+	gt.Error(other, err).HasValue("code", 404)
+	if other.errs != 1 {
+		t.Error("extractor should not be used in other test")
+	}
+}
@@ -1,15 +1,40 @@
 package gt
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
+	"unicode/utf8"
 )
 
+// UpdateGolden enables update mode of golden file test. In update mode, FileTest.EqualTo writes actual content to the golden file instead of comparing. Update mode is also enabled by setting environment variable GT_UPDATE_GOLDEN (e.g. GT_UPDATE_GOLDEN=1). A developer can bind UpdateGolden to own command line flag.
+//
+//	func TestMain(m *testing.M) {
+//		flag.BoolVar(&gt.UpdateGolden, "update", false, "update golden files")
+//		flag.Parse()
+//		os.Exit(m.Run())
+//	}
+var UpdateGolden = false
+
+func isUpdateGolden() bool {
+	if UpdateGolden {
+		return true
+	}
+	switch os.Getenv("GT_UPDATE_GOLDEN") {
+	case "", "0", "false", "FALSE", "False":
+		return false
+	default:
+		return true
+	}
+}
+
 type FileTest struct {
 	TestMeta
-	path string
+	path              string
+	normalizeNewlines bool
 }
 
 // File provides FileTest that has basic comparison methods
@@ -45,7 +70,7 @@ func (x FileTest) Describef(format string, args ...any) FileTest {
 //	gt.File(t, "testdata/no-file.txt").Exists() // Fail
 func (x FileTest) Exists() FileTest {
 	x.t.Helper()
-	if !EvalFileExists(x.path) {
+	if !evalFileExists(x.t, x.path) {
 		msg := fmt.Sprintf("file should exist, %s", x.path)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -59,7 +84,7 @@ func (x FileTest) Exists() FileTest {
 //	gt.File(t, "testdata/no-file.txt").NotExists() // Pass
 func (x FileTest) NotExists() FileTest {
 	x.t.Helper()
-	if EvalFileExists(x.path) {
+	if evalFileExists(x.t, x.path) {
 		msg := fmt.Sprintf("file should not exist, %s", x.path)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -104,6 +129,83 @@ func (x FileTest) Reader(f func(testing.TB, io.Reader)) FileTest {
 	return x
 }
 
+// NormalizeNewlines enables line ending normalization for EqualTo. CRLF and CR in both golden file and actual content are converted to LF before comparison.
+//
+//	gt.File(t, "testdata/out.golden").NormalizeNewlines().EqualTo([]byte("hello\r\n"))
+func (x FileTest) NormalizeNewlines() FileTest {
+	x.normalizeNewlines = true
+	return x
+}
+
+// EqualTo checks if content of the file (golden file) equals actual. If contents are text, line diff is shown on mismatch. If contents are binary, the first mismatching offset is shown. In update mode (see UpdateGolden), EqualTo writes actual to the file and creates missing directories instead of comparing.
+//
+//	gt.File(t, "testdata/out.golden").EqualTo(actual)
+func (x FileTest) EqualTo(actual []byte) FileTest {
+	x.t.Helper()
+
+	if isUpdateGolden() {
+		if err := os.MkdirAll(filepath.Dir(x.path), 0755); err != nil {
+			msg := fmt.Sprintf("failed to create directory of golden file, %s: %v", x.path, err)
+			x.t.Error(formatErrorMessage(x.description, msg))
+			return x
+		}
+		if err := os.WriteFile(x.path, actual, 0644); err != nil {
+			msg := fmt.Sprintf("failed to update golden file, %s: %v", x.path, err)
+			x.t.Error(formatErrorMessage(x.description, msg))
+			return x
+		}
+		x.t.Logf("golden file updated, %s", x.path)
+		return x
+	}
+
+	expect, err := os.ReadFile(x.path)
+	if err != nil {
+		msg := fmt.Sprintf("failed to read golden file, %s: %v\n(set GT_UPDATE_GOLDEN=1 to create or update golden file)", x.path, err)
+		x.t.Error(formatErrorMessage(x.description, msg))
+		return x
+	}
+
+	if x.normalizeNewlines {
+		expect = normalizeNewlines(expect)
+		actual = normalizeNewlines(actual)
+	}
+
+	if bytes.Equal(expect, actual) {
+		return x
+	}
+
+	var msg string
+	if isBinary(expect) || isBinary(actual) {
+		offset := 0
+		for offset < len(expect) && offset < len(actual) && expect[offset] == actual[offset] {
+			offset++
+		}
+		msg = fmt.Sprintf("golden file is not matched, %s\nbinary content differs at offset %d (expect %d bytes, actual %d bytes)", x.path, offset, len(expect), len(actual))
+	} else {
+		msg = fmt.Sprintf("golden file is not matched, %s\n%s", x.path, lineDiff(string(expect), string(actual)))
+	}
+	x.t.Error(formatErrorMessage(x.description, msg))
+
+	return x
+}
+
+// EqualToString is same with EqualTo, but accepts string as actual content.
+//
+//	gt.File(t, "testdata/out.golden").EqualToString(rendered)
+func (x FileTest) EqualToString(actual string) FileTest {
+	x.t.Helper()
+	return x.EqualTo([]byte(actual))
+}
+
+func normalizeNewlines(data []byte) []byte {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	return bytes.ReplaceAll(data, []byte("\r"), []byte("\n"))
+}
+
+func isBinary(data []byte) bool {
+	return bytes.IndexByte(data, 0) >= 0 || !utf8.Valid(data)
+}
+
 // Required check if error has occurred in previous test. If errors has been occurred in previous test, it immediately stop test by t.FailNow().
 func (x FileTest) Required() FileTest {
 	x.requiredWithMeta()
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/m-mizutani/gt"
@@ -99,3 +101,87 @@ func TestFileReader(t *testing.T) {
 		}
 	})
 }
+
+func TestFileEqualTo(t *testing.T) {
+	dir := t.TempDir()
+	golden := filepath.Join(dir, "out.golden")
+	if err := os.WriteFile(golden, []byte("line1\nline2\nline3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("pass with same content", func(t *testing.T) {
+		r := newRecorder()
+		gt.File(r, golden).EqualTo([]byte("line1\nline2\nline3\n"))
+		if r.errs != 0 {
+			t.Error("should pass")
+		}
+	})
+
+	t.Run("fail with line diff", func(t *testing.T) {
+		r := newRecorder()
+		gt.File(r, golden).EqualToString("line1\nline-x\nline3\n")
+		if r.errs != 1 {
+			t.Fatal("should fail")
+		}
+		if !strings.Contains(r.msgs[0], "-line2") || !strings.Contains(r.msgs[0], "+line-x") {
+			t.Errorf("unexpected diff: %s", r.msgs[0])
+		}
+	})
+
+	t.Run("normalize newlines", func(t *testing.T) {
+		r := newRecorder()
+		gt.File(r, golden).EqualTo([]byte("line1\r\nline2\r\nline3\r\n"))
+		if r.errs != 1 {
+			t.Error("should fail without normalization")
+		}
+
+		r = newRecorder()
+		gt.File(r, golden).NormalizeNewlines().EqualTo([]byte("line1\r\nline2\r\nline3\r\n"))
+		if r.errs != 0 {
+			t.Error("should pass with normalization")
+		}
+	})
+
+	t.Run("binary content", func(t *testing.T) {
+		bin := filepath.Join(dir, "bin.golden")
+		if err := os.WriteFile(bin, []byte{0x00, 0x01, 0x02}, 0644); err != nil {
+			t.Fatal(err)
+		}
+		r := newRecorder()
+		gt.File(r, bin).EqualTo([]byte{0x00, 0x01, 0xff})
+		if r.errs != 1 {
+			t.Fatal("should fail")
+		}
+		if !strings.Contains(r.msgs[0], "offset 2") {
+			t.Errorf("unexpected message: %s", r.msgs[0])
+		}
+	})
+
+	t.Run("missing golden file", func(t *testing.T) {
+		r := newRecorder()
+		gt.File(r, filepath.Join(dir, "missing.golden")).EqualTo([]byte("x"))
+		if r.errs != 1 {
+			t.Error("should fail")
+		}
+	})
+
+	t.Run("update mode creates golden file", func(t *testing.T) {
+		gt.UpdateGolden = true
+		defer func() { gt.UpdateGolden = false }()
+
+		path := filepath.Join(dir, "sub", "new.golden")
+		r := newRecorder()
+		gt.File(r, path).EqualTo([]byte("created"))
+		if r.errs != 0 {
+			t.Fatal("should pass in update mode")
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "created" {
+			t.Errorf("unexpected golden content: %s", string(data))
+		}
+	})
+}
@@ -33,8 +33,8 @@ func (m *TestMeta) requiredWithMeta() {
 
 func Equal[T any](t testing.TB, actual T, expected T) {
 	t.Helper()
-	if !EvalCompare(actual, expected) {
-		t.Error("values should be matched, but not match\n" + Diff(expected, actual))
+	if !evalCompare(t, actual, expected) {
+		t.Error("values should be matched, but not match\n" + evalDiff(t, expected, actual))
 	}
 }
 
@@ -45,8 +45,8 @@ func EQ[T any](t testing.TB, actual T, expected T) {
 
 func NotEqual[T any](t testing.TB, actual T, expected T) {
 	t.Helper()
-	if EvalCompare(actual, expected) {
-		t.Error("values should not be matched, but match\n" + Diff(expected, actual))
+	if evalCompare(t, actual, expected) {
+		t.Error("values should not be matched, but match\n" + evalDiff(t, expected, actual))
 	}
 }
 
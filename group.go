@@ -0,0 +1,80 @@
+package gt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type GroupTest struct {
+	TestMeta
+}
+
+// Group calls f with testing.TB that collects failures of assertions in f. After f exits, collected failures are reported to t as one structured block with the name and number of failures. Required() in f stops only f, and the failures collected until then are reported. t.Skip() in f also stops only f, and cleanup functions registered in f (e.g. by t.Cleanup() or t.TempDir()) are called when f exits.
+//
+//	gt.Group(t, "user record", func(t testing.TB) {
+//		gt.Value(t, user.ID).Equal(1)
+//		gt.String(t, user.Name).Equal("Alice")
+//		gt.Array(t, user.Roles).Has("admin")
+//	}).Required()
+//
+// Output on failure:
+//
+//	user record: 2 failures
+//	  [1] values are not matched
+//	      actual: Bob
+//	      expect: Alice
+//	  [2] [user] expects to have admin, but not contains
+func Group(t testing.TB, name string, f func(t testing.TB)) GroupTest {
+	t.Helper()
+
+	r := runRecorded(t, f)
+	r.replayLogs(t)
+	if r.Skipped() && !r.Failed() {
+		t.Logf("%s: skipped", name)
+	}
+
+	if r.Failed() {
+		msgs := r.errors()
+
+		var b strings.Builder
+		switch len(msgs) {
+		case 0:
+			fmt.Fprintf(&b, "%s: failed without message", name)
+		case 1:
+			fmt.Fprintf(&b, "%s: 1 failure", name)
+		default:
+			fmt.Fprintf(&b, "%s: %d failures", name, len(msgs))
+		}
+
+		for i, msg := range msgs {
+			prefix := fmt.Sprintf("  [%d] ", i+1)
+			indent := strings.Repeat(" ", len(prefix))
+			b.WriteString("\n" + prefix + strings.ReplaceAll(msg, "\n", "\n"+indent))
+		}
+
+		t.Error(b.String())
+	}
+
+	return GroupTest{
+		TestMeta: TestMeta{t: t},
+	}
+}
+
+// Describe sets a description for the test. The description will be displayed when Required() stops the test.
+func (x GroupTest) Describe(description string) GroupTest {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when Required() stops the test.
+func (x GroupTest) Describef(format string, args ...any) GroupTest {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test (including the group). If errors has been occurred, it immediately stop test by t.FailNow().
+func (x GroupTest) Required() GroupTest {
+	x.requiredWithMeta()
+	return x
+}
@@ -0,0 +1,146 @@
+package gt_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestGroup(t *testing.T) {
+	type user struct {
+		ID    int
+		Name  string
+		Roles []string
+	}
+	u := user{ID: 2, Name: "Bob", Roles: []string{"user"}}
+
+	t.Run("aggregate failures into one report", func(t *testing.T) {
+		r := newRecorder()
+		gt.Group(r, "user record", func(t testing.TB) {
+			gt.Value(t, u.ID).Describe("ID should be 1").Equal(1)
+			gt.String(t, u.Name).Equal("Bob")
+			gt.Array(t, u.Roles).Has("admin")
+		})
+
+		if r.errs != 1 {
+			t.Fatalf("should report once, but %d", r.errs)
+		}
+		msg := r.msgs[0]
+		if !strings.HasPrefix(msg, "user record: 2 failures") {
+			t.Errorf("unexpected header: %s", msg)
+		}
+		if !strings.Contains(msg, "[1] ID should be 1") || !strings.Contains(msg, "[2] [user] expects to have admin") {
+			t.Errorf("unexpected body: %s", msg)
+		}
+	})
+
+	t.Run("pass", func(t *testing.T) {
+		r := newRecorder()
+		gt.Group(r, "user record", func(t testing.TB) {
+			gt.Value(t, u.ID).Equal(2)
+		}).Required()
+
+		if r.errs != 0 || r.fails != 0 {
+			t.Errorf("should pass, but %v", r.msgs)
+		}
+	})
+
+	t.Run("Required in group stops only the group", func(t *testing.T) {
+		var reached bool
+		r := newRecorder()
+		gt.Group(r, "user record", func(t testing.TB) {
+			gt.Value(t, u.ID).Equal(1).Required()
+			reached = true
+		})
+
+		if reached {
+			t.Error("Required should stop the group")
+		}
+		if r.errs != 1 || r.fails != 0 {
+			t.Errorf("should report failure without stopping test, errs=%d fails=%d", r.errs, r.fails)
+		}
+	})
+
+	t.Run("Required after group stops test", func(t *testing.T) {
+		r := newRecorder()
+		gt.Group(r, "user record", func(t testing.TB) {
+			gt.Value(t, u.ID).Equal(1)
+		}).Describe("user must be valid").Required()
+
+		if r.fails != 1 {
+			t.Error("Required should stop test")
+		}
+		if !strings.Contains(r.msgs[len(r.msgs)-1], "user must be valid") {
+			t.Errorf("description should be shown: %v", r.msgs)
+		}
+	})
+
+	t.Run("nested group", func(t *testing.T) {
+		r := newRecorder()
+		gt.Group(r, "outer", func(t testing.TB) {
+			gt.Group(t, "inner", func(t testing.TB) {
+				gt.Value(t, 1).Equal(2)
+				gt.Value(t, 3).Equal(4)
+			})
+		})
+
+		if r.errs != 1 {
+			t.Fatalf("should report once, but %d", r.errs)
+		}
+		if !strings.Contains(r.msgs[0], "outer: 1 failure") || !strings.Contains(r.msgs[0], "inner: 2 failures") {
+			t.Errorf("unexpected report: %s", r.msgs[0])
+		}
+	})
+
+	t.Run("Skip in group stops only the group", func(t *testing.T) {
+		var reached bool
+		r := newRecorder()
+		gt.Group(r, "user record", func(t testing.TB) {
+			t.Skip("not ready")
+			reached = true
+		})
+
+		if reached {
+			t.Error("Skip should stop the group")
+		}
+		if r.errs != 0 || r.fails != 0 {
+			t.Errorf("skip should not be failure, errs=%d fails=%d", r.errs, r.fails)
+		}
+	})
+
+	t.Run("cleanup in group is called when the group exits", func(t *testing.T) {
+		var dir string
+		var called int
+		r := newRecorder()
+		gt.Group(r, "user record", func(t testing.TB) {
+			dir = t.TempDir()
+			t.Cleanup(func() { called++ })
+		})
+
+		if called != 1 {
+			t.Errorf("cleanup should be called once, but %d", called)
+		}
+		if _, err := os.Stat(dir); !os.IsNotExist(err) {
+			t.Errorf("temp dir should be removed: %v", err)
+		}
+		if len(r.cleanups) != 0 {
+			t.Error("cleanup should not be registered to outer testing.TB")
+		}
+	})
+
+	t.Run("Setenv in group is rejected", func(t *testing.T) {
+		r := newRecorder()
+		gt.Group(r, "env", func(t testing.TB) {
+			t.Setenv("GT_TEST_GROUP_ENV", "1")
+		})
+
+		if r.errs != 1 || !strings.Contains(r.msgs[0], "Setenv") {
+			t.Errorf("Setenv should be reported: %v", r.msgs)
+		}
+		if _, ok := os.LookupEnv("GT_TEST_GROUP_ENV"); ok {
+			t.Error("env should not be set")
+		}
+	})
+}
@@ -1,6 +1,13 @@
 package gt
 
-import "testing"
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+)
 
 type errorWithFail struct {
 	testing.TB
@@ -13,6 +20,10 @@ func newErrorWithFail(t testing.TB) *errorWithFail {
 	}
 }
 
+func (x *errorWithFail) unwrapTB() testing.TB {
+	return x.TB
+}
+
 func (x *errorWithFail) Error(args ...any) {
 	x.TB.Helper()
 	x.TB.Error(args...)
@@ -24,3 +35,201 @@ func (x *errorWithFail) Errorf(format string, args ...any) {
 	x.TB.Errorf(format, args...)
 	x.TB.FailNow()
 }
+
+// recordTB is testing.TB that records failures instead of reporting them to underlying testing.TB. FailNow (and also Fatal, Fatalf) and SkipNow (and also Skip, Skipf) stop only the goroutine running with recordTB. Functions registered by Cleanup (and also TempDir) are called when the goroutine exits, not at the end of the test. Setenv is not supported because environment variables are shared with other goroutines. Use runRecorded to run a function with recordTB.
+type recordTB struct {
+	testing.TB
+	mutex    sync.Mutex
+	logs     []recordLog
+	failed   bool
+	skipped  bool
+	cleanups []func()
+}
+
+type recordLog struct {
+	msg     string
+	isError bool
+}
+
+func newRecordTB(t testing.TB) *recordTB {
+	return &recordTB{TB: t}
+}
+
+// runRecorded calls f with recordTB in a new goroutine and waits until f exits, and then calls registered cleanup functions. A panic in f is recorded as a failure.
+func runRecorded(t testing.TB, f func(t testing.TB)) *recordTB {
+	r, done := startRecorded(t, f)
+	<-done
+	return r
+}
+
+// startRecorded starts f with recordTB in the same manner as runRecorded, but does not wait. done is closed after f exits and cleanup functions are called.
+func startRecorded(t testing.TB, f func(t testing.TB)) (*recordTB, <-chan struct{}) {
+	r := newRecordTB(t)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.run(func() { f(r) })
+		r.runCleanups()
+	}()
+	return r, done
+}
+
+// run calls f in a new goroutine so that runtime.Goexit by FailNow or SkipNow stops only f.
+func (x *recordTB) run(f func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			if v := recover(); v != nil {
+				x.Errorf("panic: %v", v)
+			}
+		}()
+		f()
+	}()
+	<-done
+}
+
+// runCleanups calls functions registered by Cleanup in last added, first called order.
+func (x *recordTB) runCleanups() {
+	for {
+		x.mutex.Lock()
+		if len(x.cleanups) == 0 {
+			x.mutex.Unlock()
+			return
+		}
+		f := x.cleanups[len(x.cleanups)-1]
+		x.cleanups = x.cleanups[:len(x.cleanups)-1]
+		x.mutex.Unlock()
+
+		x.run(f)
+	}
+}
+
+func (x *recordTB) Helper() {}
+
+func (x *recordTB) unwrapTB() testing.TB {
+	return x.TB
+}
+
+func (x *recordTB) add(msg string, isError bool) {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	x.logs = append(x.logs, recordLog{msg: msg, isError: isError})
+	if isError {
+		x.failed = true
+	}
+}
+
+func (x *recordTB) Log(args ...any) {
+	x.add(strings.TrimSuffix(fmt.Sprintln(args...), "\n"), false)
+}
+
+func (x *recordTB) Logf(format string, args ...any) {
+	x.add(fmt.Sprintf(format, args...), false)
+}
+
+func (x *recordTB) Error(args ...any) {
+	x.add(strings.TrimSuffix(fmt.Sprintln(args...), "\n"), true)
+}
+
+func (x *recordTB) Errorf(format string, args ...any) {
+	x.add(fmt.Sprintf(format, args...), true)
+}
+
+func (x *recordTB) Fatal(args ...any) {
+	x.Error(args...)
+	x.FailNow()
+}
+
+func (x *recordTB) Fatalf(format string, args ...any) {
+	x.Errorf(format, args...)
+	x.FailNow()
+}
+
+func (x *recordTB) Fail() {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	x.failed = true
+}
+
+func (x *recordTB) FailNow() {
+	x.Fail()
+	runtime.Goexit()
+}
+
+func (x *recordTB) Failed() bool {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	return x.failed
+}
+
+func (x *recordTB) Skip(args ...any) {
+	x.Log(args...)
+	x.SkipNow()
+}
+
+func (x *recordTB) Skipf(format string, args ...any) {
+	x.Logf(format, args...)
+	x.SkipNow()
+}
+
+func (x *recordTB) SkipNow() {
+	x.mutex.Lock()
+	x.skipped = true
+	x.mutex.Unlock()
+	runtime.Goexit()
+}
+
+func (x *recordTB) Skipped() bool {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	return x.skipped
+}
+
+func (x *recordTB) Cleanup(f func()) {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	x.cleanups = append(x.cleanups, f)
+}
+
+func (x *recordTB) TempDir() string {
+	dir, err := os.MkdirTemp("", "gt")
+	if err != nil {
+		x.Fatalf("TempDir: %v", err)
+	}
+	x.Cleanup(func() {
+		if err := os.RemoveAll(dir); err != nil {
+			x.Errorf("TempDir RemoveAll cleanup: %v", err)
+		}
+	})
+	return dir
+}
+
+func (x *recordTB) Setenv(key, value string) {
+	x.Fatalf("Setenv(%q) is not supported in Group, Each, Eventually and Consistently; call Setenv of outer testing.TB instead", key)
+}
+
+// errors returns recorded error messages
+func (x *recordTB) errors() []string {
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	var msgs []string
+	for _, log := range x.logs {
+		if log.isError {
+			msgs = append(msgs, log.msg)
+		}
+	}
+	return msgs
+}
+
+// replayLogs passes recorded log (not error) messages to t
+func (x *recordTB) replayLogs(t testing.TB) {
+	t.Helper()
+	x.mutex.Lock()
+	defer x.mutex.Unlock()
+	for _, log := range x.logs {
+		if !log.isError {
+			t.Log(log.msg)
+		}
+	}
+}
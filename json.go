@@ -0,0 +1,339 @@
+package gt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type JSONTest struct {
+	TestMeta
+	actual any
+	valid  bool
+}
+
+// JSON provides JSONTest that compares JSON documents semantically. Key order and whitespace are ignored. Numbers are decoded as json.Number and compared by exact decimal value to avoid float64 precision problems. If actual is not valid JSON, test will fail.
+//
+//	gt.JSON(t, `{"id": 1, "tags": ["a", "b"]}`).
+//		Equal(`{"tags":["a","b"],"id":1}`) // Pass
+func JSON[T []byte | string](t testing.TB, actual T) JSONTest {
+	t.Helper()
+	v, err := decodeJSON([]byte(actual))
+	if err != nil {
+		t.Errorf("invalid JSON, %v", err)
+	}
+	return JSONTest{
+		TestMeta: TestMeta{t: t},
+		actual:   v,
+		valid:    err == nil,
+	}
+}
+
+// J is sugar syntax of JSON
+func J[T []byte | string](t testing.TB, actual T) JSONTest {
+	t.Helper()
+	return JSON(t, actual)
+}
+
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	// dec.More() is not enough because it reports false for a trailing ']' or '}'.
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after top-level value")
+	}
+	return v, nil
+}
+
+// Describe sets a description for the test. The description will be displayed when the test fails.
+func (x JSONTest) Describe(description string) JSONTest {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when the test fails.
+func (x JSONTest) Describef(format string, args ...any) JSONTest {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test. If errors has been occurred in previous test, it immediately stop test by t.FailNow().
+func (x JSONTest) Required() JSONTest {
+	x.requiredWithMeta()
+	return x
+}
+
+// Equal checks if actual JSON document is semantically equal to expect. On failure, JSON pointer paths of differing values are reported.
+//
+//	gt.JSON(t, `{"a": 1, "b": [1, 2]}`).Equal(`{"b":[1,2],"a":1}`) // Pass
+//	gt.JSON(t, `{"a": 1, "b": [1, 2]}`).Equal(`{"a":1,"b":[1,3]}`) // Fail: /b/1
+func (x JSONTest) Equal(expect string) JSONTest {
+	x.t.Helper()
+	if !x.valid {
+		return x
+	}
+
+	v, err := decodeJSON([]byte(expect))
+	if err != nil {
+		msg := fmt.Sprintf("expected value is invalid JSON, %v", err)
+		x.t.Error(formatErrorMessage(x.description, msg))
+		return x
+	}
+
+	if diffs := diffJSON("", v, x.actual); len(diffs) > 0 {
+		msg := "JSON documents are not matched\n" + strings.Join(diffs, "\n")
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// NotEqual checks if actual JSON document is not semantically equal to expect.
+//
+//	gt.JSON(t, `{"a": 1}`).NotEqual(`{"a": 2}`) // Pass
+//	gt.JSON(t, `{"a": 1}`).NotEqual(`{"a":1}`)  // Fail
+func (x JSONTest) NotEqual(expect string) JSONTest {
+	x.t.Helper()
+	if !x.valid {
+		return x
+	}
+
+	v, err := decodeJSON([]byte(expect))
+	if err != nil {
+		msg := fmt.Sprintf("expected value is invalid JSON, %v", err)
+		x.t.Error(formatErrorMessage(x.description, msg))
+		return x
+	}
+
+	if len(diffJSON("", v, x.actual)) == 0 {
+		msg := fmt.Sprintf("JSON documents should not be matched, %s", formatJSON(x.actual))
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Has checks if a value specified by JSON pointer (RFC 6901) exists in actual JSON document.
+//
+//	gt.JSON(t, `{"items": [{"id": 1}]}`).Has("/items/0/id") // Pass
+//	gt.JSON(t, `{"items": [{"id": 1}]}`).Has("/items/1")    // Fail
+func (x JSONTest) Has(pointer string) JSONTest {
+	x.t.Helper()
+	if !x.valid {
+		return x
+	}
+
+	if _, err := lookupJSON(x.actual, pointer); err != nil {
+		msg := fmt.Sprintf("JSON pointer %q is not found, %v", pointer, err)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// NotHas checks if a value specified by JSON pointer (RFC 6901) does not exist in actual JSON document.
+//
+//	gt.JSON(t, `{"items": [{"id": 1}]}`).NotHas("/items/1")    // Pass
+//	gt.JSON(t, `{"items": [{"id": 1}]}`).NotHas("/items/0/id") // Fail
+func (x JSONTest) NotHas(pointer string) JSONTest {
+	x.t.Helper()
+	if !x.valid {
+		return x
+	}
+
+	if v, err := lookupJSON(x.actual, pointer); err == nil {
+		msg := fmt.Sprintf("JSON pointer %q should not exist, but found %s", pointer, formatJSON(v))
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// At calls f with testing.TB and a value specified by JSON pointer (RFC 6901). Objects are given as map[string]any, arrays as []any and numbers as json.Number. If the pointer is not found, f is not called and test will trigger error.
+//
+//	gt.JSON(t, `{"items": [{"id": 1}]}`).At("/items/0/id", func(t testing.TB, v any) {
+//		gt.Value(t, v).Equal(json.Number("1")) // Pass
+//	})
+func (x JSONTest) At(pointer string, f func(t testing.TB, v any)) JSONTest {
+	x.t.Helper()
+	if !x.valid {
+		return x
+	}
+
+	v, err := lookupJSON(x.actual, pointer)
+	if err != nil {
+		msg := fmt.Sprintf("JSON pointer %q is not found, %v", pointer, err)
+		x.t.Error(formatErrorMessage(x.description, msg))
+		return x
+	}
+
+	f(x.t, v)
+	return x
+}
+
+func lookupJSON(v any, pointer string) (any, error) {
+	if pointer == "" {
+		return v, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("JSON pointer must start with '/'")
+	}
+
+	cur := v
+	path := ""
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		path += "/" + escapeJSONPointer(token)
+
+		switch node := cur.(type) {
+		case map[string]any:
+			next, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("key is not found at %s", path)
+			}
+			cur = next
+
+		case []any:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 {
+				return nil, fmt.Errorf("invalid array index at %s", path)
+			}
+			if idx >= len(node) {
+				return nil, fmt.Errorf("index out of range at %s (length %d)", path, len(node))
+			}
+			cur = node[idx]
+
+		default:
+			return nil, fmt.Errorf("can not traverse %s value at %s", jsonTypeName(cur), path)
+		}
+	}
+
+	return cur, nil
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// diffJSON returns differences between expect and actual with JSON pointer paths.
+func diffJSON(path string, expect, actual any) []string {
+	at := path
+	if at == "" {
+		at = "(root)"
+	}
+
+	switch e := expect.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(e)+len(a))
+		for k := range e {
+			keys = append(keys, k)
+		}
+		for k := range a {
+			if _, ok := e[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+
+		var diffs []string
+		for _, k := range keys {
+			p := path + "/" + escapeJSONPointer(k)
+			ev, inExpect := e[k]
+			av, inActual := a[k]
+			switch {
+			case !inActual:
+				diffs = append(diffs, fmt.Sprintf("%s: missing in actual, expect %s", p, formatJSON(ev)))
+			case !inExpect:
+				diffs = append(diffs, fmt.Sprintf("%s: unexpected in actual, actual %s", p, formatJSON(av)))
+			default:
+				diffs = append(diffs, diffJSON(p, ev, av)...)
+			}
+		}
+		return diffs
+
+	case []any:
+		a, ok := actual.([]any)
+		if !ok {
+			break
+		}
+
+		var diffs []string
+		for i := 0; i < len(e) || i < len(a); i++ {
+			p := path + "/" + strconv.Itoa(i)
+			switch {
+			case i >= len(a):
+				diffs = append(diffs, fmt.Sprintf("%s: missing in actual, expect %s", p, formatJSON(e[i])))
+			case i >= len(e):
+				diffs = append(diffs, fmt.Sprintf("%s: unexpected in actual, actual %s", p, formatJSON(a[i])))
+			default:
+				diffs = append(diffs, diffJSON(p, e[i], a[i])...)
+			}
+		}
+		return diffs
+
+	case json.Number:
+		if a, ok := actual.(json.Number); ok && equalJSONNumber(e, a) {
+			return nil
+		}
+
+	default:
+		if jsonTypeName(expect) == jsonTypeName(actual) && expect == actual {
+			return nil
+		}
+	}
+
+	return []string{fmt.Sprintf("%s: expect %s, actual %s", at, formatJSON(expect), formatJSON(actual))}
+}
+
+func equalJSONNumber(a, b json.Number) bool {
+	if a == b {
+		return true
+	}
+
+	ra, okA := new(big.Rat).SetString(string(a))
+	rb, okB := new(big.Rat).SetString(string(b))
+	return okA && okB && ra.Cmp(rb) == 0
+}
+
+func jsonTypeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func formatJSON(v any) string {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	return string(raw)
+}
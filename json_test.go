@@ -0,0 +1,146 @@
+package gt_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestJSON(t *testing.T) {
+	doc := `{"id": 1, "name": "blue", "items": [{"id": 10}, {"id": 20}], "price": 1.10}`
+
+	testCases := map[string]struct {
+		test func(j gt.JSONTest)
+		pass bool
+	}{
+		"Equal: pass with different key order and whitespace": {
+			test: func(j gt.JSONTest) {
+				j.Equal(`{"items":[{"id":10},{"id":20}],"name":"blue","price":1.1,"id":1}`)
+			},
+			pass: true,
+		},
+		"Equal: fail with different value": {
+			test: func(j gt.JSONTest) {
+				j.Equal(`{"id":1,"name":"blue","items":[{"id":10},{"id":30}],"price":1.1}`)
+			},
+			pass: false,
+		},
+		"Equal: fail with missing key": {
+			test: func(j gt.JSONTest) {
+				j.Equal(`{"id":1,"name":"blue","items":[{"id":10},{"id":20}]}`)
+			},
+			pass: false,
+		},
+		"Equal: fail with invalid expected JSON": {
+			test: func(j gt.JSONTest) {
+				j.Equal(`{"id":`)
+			},
+			pass: false,
+		},
+		"NotEqual: pass": {
+			test: func(j gt.JSONTest) {
+				j.NotEqual(`{"id":2}`)
+			},
+			pass: true,
+		},
+		"NotEqual: fail": {
+			test: func(j gt.JSONTest) {
+				j.NotEqual(`{"id":1,"name":"blue","items":[{"id":10},{"id":20}],"price":1.100}`)
+			},
+			pass: false,
+		},
+		"Has: pass": {
+			test: func(j gt.JSONTest) {
+				j.Has("/items/1/id")
+			},
+			pass: true,
+		},
+		"Has: fail with out of range": {
+			test: func(j gt.JSONTest) {
+				j.Has("/items/2")
+			},
+			pass: false,
+		},
+		"NotHas: pass": {
+			test: func(j gt.JSONTest) {
+				j.NotHas("/tags")
+			},
+			pass: true,
+		},
+		"NotHas: fail": {
+			test: func(j gt.JSONTest) {
+				j.NotHas("/name")
+			},
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.JSON(r, doc))
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestJSONDiffPath(t *testing.T) {
+	r := newRecorder()
+	gt.JSON(r, []byte(`{"items": [{"id": 10}, {"id": 20}], "a/b": true}`)).
+		Equal(`{"items": [{"id": 10}, {"id": 21}], "a/b": false}`)
+
+	if r.errs != 1 {
+		t.Fatalf("should fail once, but %d", r.errs)
+	}
+	if !strings.Contains(r.msgs[0], "/items/1/id: expect 21, actual 20") {
+		t.Errorf("diff path not found: %s", r.msgs[0])
+	}
+	if !strings.Contains(r.msgs[0], "/a~1b: expect false, actual true") {
+		t.Errorf("escaped diff path not found: %s", r.msgs[0])
+	}
+}
+
+func TestJSONAt(t *testing.T) {
+	var called int
+	gt.JSON(t, `{"items": [{"id": 12345678901234567890}]}`).At("/items/0/id", func(t testing.TB, v any) {
+		called++
+		gt.Value(t, v).Equal(json.Number("12345678901234567890"))
+	})
+	if called != 1 {
+		t.Errorf("callback should be called once, but %d", called)
+	}
+
+	r := newRecorder()
+	gt.JSON(r, `{"items": []}`).At("/items/0/id", func(t testing.TB, v any) {
+		t.Error("should not be called")
+	})
+	if r.errs != 1 {
+		t.Error("should fail with missing pointer")
+	}
+}
+
+func TestJSONInvalid(t *testing.T) {
+	r := newRecorder()
+	gt.JSON(r, `{"id": 1`).Equal(`{"id": 1}`)
+	if r.errs != 1 {
+		t.Errorf("should fail once by invalid JSON, but %d", r.errs)
+	}
+
+	for _, actual := range []string{`{"id": 1}]`, `{"id": 1} }`, `{"id": 1} {}`, `1 2`} {
+		r := newRecorder()
+		gt.JSON(r, actual).Equal(`{"id": 1}`)
+		if r.errs != 1 {
+			t.Errorf("should fail once by trailing data of %q, but %d", actual, r.errs)
+		}
+	}
+
+	r = newRecorder()
+	gt.JSON(r, "{\"id\": 1} \n\t").Equal(`{"id": 1}`)
+	if r.errs != 0 {
+		t.Errorf("trailing white spaces should be allowed, but %v", r.msgs)
+	}
+}
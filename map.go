@@ -2,6 +2,9 @@ package gt
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -67,8 +70,8 @@ func (x MapTest[K, V]) Describef(format string, args ...any) MapTest[K, V] {
 func (x MapTest[K, V]) Equal(expect map[K]V) MapTest[K, V] {
 	x.t.Helper()
 
-	if !EvalCompare(x.actual, expect) {
-		msg := "maps are not matched\n" + Diff(expect, x.actual)
+	if !evalCompare(x.t, x.actual, expect) {
+		msg := "maps are not matched\n" + evalDiff(x.t, expect, x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 		return x
 	}
@@ -76,6 +79,23 @@ func (x MapTest[K, V]) Equal(expect map[K]V) MapTest[K, V] {
 	return x
 }
 
+// EqualWith checks if expect equals given actual map by go-cmp with options. The options are applied to both comparison and diff output.
+//
+//	m := map[string][]int{
+//		"blue": {},
+//	}
+//	gt.Map(t, m).EqualWith(map[string][]int{"blue": nil}, gt.EquateEmpty()) // <- Pass
+func (x MapTest[K, V]) EqualWith(expect map[K]V, options ...CompareOption) MapTest[K, V] {
+	x.t.Helper()
+	cfg := newCompareConfig(options)
+	if !cfg.equal(expect, x.actual) {
+		msg := "maps are not matched\n" + cfg.diff(expect, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
 // NotEqual checks if expect does not equal given actual map.
 //
 //	m := map[string]int{
@@ -91,7 +111,7 @@ func (x MapTest[K, V]) Equal(expect map[K]V) MapTest[K, V] {
 func (x MapTest[K, V]) NotEqual(expect map[K]V) MapTest[K, V] {
 	x.t.Helper()
 
-	if EvalCompare(x.actual, expect) {
+	if evalCompare(x.t, x.actual, expect) {
 		msg := fmt.Sprintf("maps should not be matched, %+v", x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 		return x
@@ -114,7 +134,7 @@ func (x MapTest[K, V]) EqualAt(key K, expect V) MapTest[K, V] {
 	if v, ok := x.actual[key]; !ok {
 		msg := fmt.Sprintf("key '%+v' is not found in the map", key)
 		x.t.Error(formatErrorMessage(x.description, msg))
-	} else if !EvalCompare(v, expect) {
+	} else if !evalCompare(x.t, v, expect) {
 		msg := fmt.Sprintf("map[%+v] is expected %+v, but actual is %+v", key, expect, v)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -136,7 +156,7 @@ func (x MapTest[K, V]) NotEqualAt(key K, expect V) MapTest[K, V] {
 	if v, ok := x.actual[key]; !ok {
 		msg := fmt.Sprintf("key '%+v' is not found in the map", key)
 		x.t.Error(formatErrorMessage(x.description, msg))
-	} else if EvalCompare(v, expect) {
+	} else if evalCompare(x.t, v, expect) {
 		msg := fmt.Sprintf("map[%+v] is expected %+v, but actual is %+v", key, expect, v)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -191,7 +211,7 @@ func (x MapTest[K, V]) HasValue(expect V) MapTest[K, V] {
 	x.t.Helper()
 
 	for i := range x.actual {
-		if EvalCompare(x.actual[i], expect) {
+		if evalCompare(x.t, x.actual[i], expect) {
 			return x
 		}
 	}
@@ -212,7 +232,7 @@ func (x MapTest[K, V]) NotHasValue(expect V) MapTest[K, V] {
 	x.t.Helper()
 
 	for i := range x.actual {
-		if EvalCompare(x.actual[i], expect) {
+		if evalCompare(x.t, x.actual[i], expect) {
 			msg := "expected not contain, but got the value"
 			x.t.Error(formatErrorMessage(x.description, msg))
 			break
@@ -226,7 +246,7 @@ func (x MapTest[K, V]) hasKeyValue(expectKey K, expectValue V) bool {
 	x.t.Helper()
 
 	for k := range x.actual {
-		if EvalCompare(k, expectKey) && EvalCompare(x.actual[k], expectValue) {
+		if evalCompare(x.t, k, expectKey) && evalCompare(x.t, x.actual[k], expectValue) {
 			return true
 		}
 	}
@@ -322,3 +342,213 @@ func (x MapTest[K, V]) At(key K, f func(t testing.TB, v V)) MapTest[K, V] {
 
 	return x
 }
+
+// Each calls f with testing.TB, key and value of each entry in the map. Entries are visited in order of formatted key to make output stable. Failures in f are reported with the key prefix like "[blue] values are not matched", and t.FailNow() (e.g. by Required()) in f stops only the call for the entry. If EachSubtest option is given, f is called in a subtest named by the key.
+//
+//	gt.Map(t, users).Each(func(t testing.TB, id string, u User) {
+//		gt.Value(t, u.ID).Equal(id)
+//	})
+func (x MapTest[K, V]) Each(f func(t testing.TB, k K, v V), options ...EachOption) MapTest[K, V] {
+	x.t.Helper()
+	cfg := newEachConfig(options)
+
+	for _, k := range sortedKeys(x.actual) {
+		k, v := k, x.actual[k]
+		x.runEach(cfg, fmt.Sprintf("%+v", k), func(t testing.TB) {
+			f(t, k, v)
+		})
+	}
+
+	return x
+}
+
+// Len returns NumberTest of number of entries in the map. testing.TB and description are kept.
+//
+//	gt.Map(t, m).Len().Greater(2)
+func (x MapTest[K, V]) Len() NumberTest[int] {
+	x.t.Helper()
+	return NumberTest[int]{
+		TestMeta: x.TestMeta,
+		actual:   len(x.actual),
+	}
+}
+
+// ContainsSubset checks if actual map contains all entries of partial. Other keys in actual map are ignored. If both values of a key are maps (including maps in interface such as map[string]any), they are compared recursively in the same manner, so partial can be a part of nested map. On failure, missing keys and keys with different values are reported with diff.
+//
+//	m := map[string]any{
+//		"name": "app",
+//		"port": 8080,
+//		"db":   map[string]any{"host": "localhost", "pool": 10},
+//	}
+//	gt.Map(t, m).ContainsSubset(map[string]any{
+//		"port": 8080,
+//		"db":   map[string]any{"host": "localhost"},
+//	}) // Pass
+func (x MapTest[K, V]) ContainsSubset(partial map[K]V) MapTest[K, V] {
+	x.t.Helper()
+
+	var d mapDiff
+	x.subsetDiff("", reflect.ValueOf(partial), reflect.ValueOf(x.actual), &d)
+	if !d.empty() {
+		x.t.Error(formatErrorMessage(x.description, "map does not contain subset\n"+d.String()))
+	}
+
+	return x
+}
+
+// HasKeys checks if actual map has all keys.
+//
+//	m := map[string]int{"blue": 5, "red": 1}
+//	gt.Map(t, m).HasKeys("blue", "red")    // Pass
+//	gt.Map(t, m).HasKeys("blue", "orange") // Fail
+func (x MapTest[K, V]) HasKeys(keys ...K) MapTest[K, V] {
+	x.t.Helper()
+
+	var d mapDiff
+	for _, k := range keys {
+		if _, ok := x.actual[k]; !ok {
+			d.missing = append(d.missing, fmt.Sprintf("%+v", k))
+		}
+	}
+	if !d.empty() {
+		x.t.Error(formatErrorMessage(x.description, "map does not have expected keys\n"+d.String()))
+	}
+
+	return x
+}
+
+// HasOnlyKeys checks if actual map has no keys other than keys. Keys that are not in actual map are allowed.
+//
+//	m := map[string]int{"blue": 5, "red": 1}
+//	gt.Map(t, m).HasOnlyKeys("blue", "red", "orange") // Pass
+//	gt.Map(t, m).HasOnlyKeys("blue")                  // Fail
+func (x MapTest[K, V]) HasOnlyKeys(keys ...K) MapTest[K, V] {
+	x.t.Helper()
+
+	allowed := make(map[K]struct{}, len(keys))
+	for _, k := range keys {
+		allowed[k] = struct{}{}
+	}
+
+	var d mapDiff
+	for _, k := range sortedKeys(x.actual) {
+		if _, ok := allowed[k]; !ok {
+			d.extra = append(d.extra, fmt.Sprintf("%+v", k))
+		}
+	}
+	if !d.empty() {
+		x.t.Error(formatErrorMessage(x.description, "map has unexpected keys\n"+d.String()))
+	}
+
+	return x
+}
+
+// KeysEqualUnordered checks if set of keys in actual map equals keys regardless of order.
+//
+//	m := map[string]int{"blue": 5, "red": 1}
+//	gt.Map(t, m).KeysEqualUnordered([]string{"red", "blue"}) // Pass
+//	gt.Map(t, m).KeysEqualUnordered([]string{"red"})         // Fail
+func (x MapTest[K, V]) KeysEqualUnordered(keys []K) MapTest[K, V] {
+	x.t.Helper()
+
+	expected := make(map[K]struct{}, len(keys))
+	var d mapDiff
+	for _, k := range keys {
+		expected[k] = struct{}{}
+		if _, ok := x.actual[k]; !ok {
+			d.missing = append(d.missing, fmt.Sprintf("%+v", k))
+		}
+	}
+	for _, k := range sortedKeys(x.actual) {
+		if _, ok := expected[k]; !ok {
+			d.extra = append(d.extra, fmt.Sprintf("%+v", k))
+		}
+	}
+	if !d.empty() {
+		x.t.Error(formatErrorMessage(x.description, "map keys are not matched\n"+d.String()))
+	}
+
+	return x
+}
+
+// mapDiff has keys that are missing in actual map, keys that are not expected, and diffs of keys whose values differ.
+type mapDiff struct {
+	missing []string
+	extra   []string
+	differ  []string
+}
+
+func (x *mapDiff) empty() bool {
+	return len(x.missing) == 0 && len(x.extra) == 0 && len(x.differ) == 0
+}
+
+func (x *mapDiff) String() string {
+	var lines []string
+	if len(x.missing) > 0 {
+		lines = append(lines, "missing keys: "+strings.Join(x.missing, ", "))
+	}
+	if len(x.extra) > 0 {
+		lines = append(lines, "extra keys: "+strings.Join(x.extra, ", "))
+	}
+	if len(x.differ) > 0 {
+		lines = append(lines, "different values:")
+		lines = append(lines, x.differ...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// subsetDiff compares entries of expect with actual recursively, and records differences to d with dot separated key path.
+func (x MapTest[K, V]) subsetDiff(path string, expect, actual reflect.Value, d *mapDiff) {
+	x.t.Helper()
+
+	keys := expect.MapKeys()
+	labels := make([]string, len(keys))
+	for i, k := range keys {
+		labels[i] = fmt.Sprintf("%+v", k.Interface())
+	}
+	sort.Sort(keyLabels{keys: keys, labels: labels})
+
+	for i, k := range keys {
+		keyPath := labels[i]
+		if path != "" {
+			keyPath = path + "." + labels[i]
+		}
+
+		av := actual.MapIndex(k)
+		if !av.IsValid() {
+			d.missing = append(d.missing, keyPath)
+			continue
+		}
+		ev := expect.MapIndex(k)
+
+		if en, an := unwrapInterface(ev), unwrapInterface(av); en.Kind() == reflect.Map && an.Kind() == reflect.Map && en.Type().Key() == an.Type().Key() {
+			x.subsetDiff(keyPath, en, an, d)
+			continue
+		}
+
+		if !evalCompare(x.t, ev.Interface(), av.Interface()) {
+			diff := evalDiff(x.t, ev.Interface(), av.Interface())
+			d.differ = append(d.differ, "  "+keyPath+":\n    "+strings.ReplaceAll(diff, "\n", "\n    "))
+		}
+	}
+}
+
+func unwrapInterface(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+// keyLabels sorts map keys by formatted labels.
+type keyLabels struct {
+	keys   []reflect.Value
+	labels []string
+}
+
+func (x keyLabels) Len() int           { return len(x.keys) }
+func (x keyLabels) Less(i, j int) bool { return x.labels[i] < x.labels[j] }
+func (x keyLabels) Swap(i, j int) {
+	x.keys[i], x.keys[j] = x.keys[j], x.keys[i]
+	x.labels[i], x.labels[j] = x.labels[j], x.labels[i]
+}
@@ -274,6 +274,84 @@ func TestMap(t *testing.T) {
 				pass: false,
 			},
 		},
+
+		"ContainsSubset": {
+			"pass": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.ContainsSubset(map[string]int{"void": 1, "blue": 5})
+				},
+				pass: true,
+			},
+			"pass with empty": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.ContainsSubset(map[string]int{})
+				},
+				pass: true,
+			},
+			"fail by value": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.ContainsSubset(map[string]int{"void": 2})
+				},
+				pass: false,
+			},
+			"fail by key": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.ContainsSubset(map[string]int{"orange": 1})
+				},
+				pass: false,
+			},
+		},
+
+		"HasKeys": {
+			"pass": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.HasKeys("void", "blue")
+				},
+				pass: true,
+			},
+			"fail": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.HasKeys("void", "orange")
+				},
+				pass: false,
+			},
+		},
+
+		"HasOnlyKeys": {
+			"pass": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.HasOnlyKeys("void", "white", "blue", "orange")
+				},
+				pass: true,
+			},
+			"fail": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.HasOnlyKeys("void", "white")
+				},
+				pass: false,
+			},
+		},
+
+		"KeysEqualUnordered": {
+			"pass": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.KeysEqualUnordered([]string{"blue", "void", "white"})
+				},
+				pass: true,
+			},
+			"fail with missing": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.KeysEqualUnordered([]string{"blue", "void", "white", "orange"})
+				},
+				pass: false,
+			},
+			"fail with extra": {
+				test: func(mt gt.MapTest[string, int]) {
+					mt.KeysEqualUnordered([]string{"blue", "void"})
+				},
+				pass: false,
+			},
+		},
 	}
 
 	for feature, cases := range testCases {
@@ -292,3 +370,52 @@ func TestMap(t *testing.T) {
 		})
 	}
 }
+
+func TestMapContainsSubsetNested(t *testing.T) {
+	cfg := map[string]any{
+		"name": "app",
+		"port": 8080,
+		"db": map[string]any{
+			"host": "localhost",
+			"pool": 10,
+		},
+	}
+
+	t.Run("pass", func(t *testing.T) {
+		r := newRecorder()
+		gt.Map(r, cfg).ContainsSubset(map[string]any{
+			"port": 8080,
+			"db":   map[string]any{"host": "localhost"},
+		})
+		gt.Number(t, r.errs).Equal(0)
+	})
+
+	t.Run("fail with diff", func(t *testing.T) {
+		r := newRecorder()
+		gt.Map(r, cfg).ContainsSubset(map[string]any{
+			"port":  80,
+			"debug": true,
+			"db":    map[string]any{"host": "db.example.com", "user": "root"},
+		})
+		gt.Number(t, r.errs).Equal(1)
+		gt.String(t, r.msgs[0]).
+			Contains("missing keys: db.user, debug").
+			Contains("different values:\n  db.host:").
+			Contains("  port:")
+	})
+
+	t.Run("fail when nested value is not map", func(t *testing.T) {
+		r := newRecorder()
+		gt.Map(r, cfg).ContainsSubset(map[string]any{
+			"name": map[string]any{"first": "app"},
+		})
+		gt.Number(t, r.errs).Equal(1)
+	})
+}
+
+func TestMapKeysMessage(t *testing.T) {
+	r := newRecorder()
+	gt.Map(r, map[string]int{"a": 1, "b": 2, "c": 3}).KeysEqualUnordered([]string{"a", "d"})
+	gt.Number(t, r.errs).Equal(1)
+	gt.String(t, r.msgs[0]).Contains("missing keys: d\nextra keys: b, c")
+}
@@ -9,9 +9,10 @@ import (
 type recorder struct {
 	testing.TB
 
-	errs  int
-	fails int
-	msgs  []string
+	errs     int
+	fails    int
+	msgs     []string
+	cleanups []func()
 }
 
 func newRecorder() *recorder {
@@ -45,3 +46,18 @@ func (x *recorder) FailNow() {
 func (x *recorder) Failed() bool {
 	return x.errs > 0
 }
+
+func (x *recorder) Log(args ...any) {}
+
+func (x *recorder) Logf(format string, args ...any) {}
+
+func (x *recorder) Cleanup(f func()) {
+	x.cleanups = append(x.cleanups, f)
+}
+
+func (x *recorder) runCleanups() {
+	for i := len(x.cleanups) - 1; i >= 0; i-- {
+		x.cleanups[i]()
+	}
+	x.cleanups = nil
+}
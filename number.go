@@ -2,6 +2,8 @@ package gt
 
 import (
 	"fmt"
+	"math"
+	"strings"
 	"testing"
 )
 
@@ -12,6 +14,12 @@ type number interface {
 		float32 | float64
 }
 
+type integer interface {
+	int | uint |
+		int8 | int16 | int32 | int64 |
+		uint8 | uint16 | uint32 | uint64
+}
+
 type NumberTest[T number] struct {
 	TestMeta
 	actual T
@@ -57,7 +65,7 @@ func (x NumberTest[T]) Describef(format string, args ...any) NumberTest[T] {
 func (x NumberTest[T]) Equal(expect T) NumberTest[T] {
 	x.t.Helper()
 	if x.actual != expect {
-		msg := "numbers are not matched\n" + Diff(expect, x.actual)
+		msg := "numbers are not matched\n" + evalDiff(x.t, expect, x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
 
@@ -146,3 +154,391 @@ func (x NumberTest[T]) Required() NumberTest[T] {
 	x.requiredWithMeta()
 	return x
 }
+
+// InDelta checks if the absolute difference between actual and expected is delta or less. Values are compared as float64. NaN never satisfies InDelta.
+//
+//	gt.Number(t, 0.1+0.2).InDelta(0.3, 1e-9) // Pass
+//	gt.Number(t, 10).InDelta(12, 1)          // Fail
+func (x NumberTest[T]) InDelta(expected, delta T) NumberTest[T] {
+	x.t.Helper()
+
+	if d := math.Abs(float64(x.actual) - float64(expected)); !(d <= float64(delta)) {
+		msg := fmt.Sprintf("got %+v, want %+v within delta %+v (actual delta: %g)", x.actual, expected, delta, d)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// InEpsilon checks if the relative error between actual and expected, |actual - expected| / |expected|, is epsilon or less. If expected is zero, actual must be zero. NaN never satisfies InEpsilon.
+//
+//	gt.Number(t, 101.0).InEpsilon(100, 0.01) // Pass
+//	gt.Number(t, 102.0).InEpsilon(100, 0.01) // Fail
+func (x NumberTest[T]) InEpsilon(expected T, epsilon float64) NumberTest[T] {
+	x.t.Helper()
+
+	if ok, rel := inEpsilon(float64(x.actual), float64(expected), epsilon); !ok {
+		msg := fmt.Sprintf("got %+v, want %+v within relative epsilon %g (actual relative error: %g)", x.actual, expected, epsilon, rel)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// InULP checks if actual is within n ULPs (units in the last place) of expected. For float32 the distance is counted in float32 precision, and for integer types one ULP is 1. NaN never satisfies InULP.
+//
+//	f := 1.0
+//	gt.Number(t, math.Nextafter(f, 2)).InULP(f, 1) // Pass
+func (x NumberTest[T]) InULP(expected T, n uint64) NumberTest[T] {
+	x.t.Helper()
+
+	if ok, d := inULP(x.actual, expected, n); !ok {
+		msg := fmt.Sprintf("got %+v, want %+v within %d ULP (actual distance: %s)", x.actual, expected, n, d)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// IsNaN checks if actual value is NaN. Integer values are never NaN.
+//
+//	gt.Number(t, math.NaN()).IsNaN() // Pass
+func (x NumberTest[T]) IsNaN() NumberTest[T] {
+	x.t.Helper()
+	if !math.IsNaN(float64(x.actual)) {
+		msg := fmt.Sprintf("got %+v, want NaN", x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// IsInf checks if actual value is an infinity, according to sign. If sign > 0, actual must be positive infinity. If sign < 0, actual must be negative infinity. If sign == 0, actual can be either infinity. Integer values are never infinity.
+//
+//	gt.Number(t, math.Inf(1)).IsInf(0)  // Pass
+//	gt.Number(t, math.Inf(1)).IsInf(-1) // Fail
+func (x NumberTest[T]) IsInf(sign int) NumberTest[T] {
+	x.t.Helper()
+	if !math.IsInf(float64(x.actual), sign) {
+		want := "infinity"
+		if sign > 0 {
+			want = "+Inf"
+		} else if sign < 0 {
+			want = "-Inf"
+		}
+		msg := fmt.Sprintf("got %+v, want %s", x.actual, want)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// IsFinite checks if actual value is neither NaN nor infinity.
+//
+//	gt.Number(t, 1.5).IsFinite()        // Pass
+//	gt.Number(t, math.NaN()).IsFinite() // Fail
+func (x NumberTest[T]) IsFinite() NumberTest[T] {
+	x.t.Helper()
+	if f := float64(x.actual); math.IsNaN(f) || math.IsInf(f, 0) {
+		msg := fmt.Sprintf("got %+v, want finite number", x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// ArrayInDelta checks if each element of actual array is within delta of the element at the same index of expect. Lengths must be the same. All elements out of tolerance are reported with index and actual delta.
+//
+//	gt.ArrayInDelta(gt.Array(t, []float64{0.1 + 0.2, 1.0}), []float64{0.3, 1.0}, 1e-9) // Pass
+func ArrayInDelta[T float32 | float64](x ArrayTest[T], expect []T, delta T) ArrayTest[T] {
+	x.t.Helper()
+
+	x.checkElementwise(expect, func(a, e T) (bool, string) {
+		d := math.Abs(float64(a) - float64(e))
+		return d <= float64(delta), fmt.Sprintf("delta %g", d)
+	}, fmt.Sprintf("delta %+v", delta))
+
+	return x
+}
+
+// ArrayInEpsilon checks if each element of actual array is within relative epsilon of the element at the same index of expect. Lengths must be the same. All elements out of tolerance are reported with index and actual relative error.
+//
+//	gt.ArrayInEpsilon(gt.Array(t, []float64{101, 200}), []float64{100, 200}, 0.01) // Pass
+func ArrayInEpsilon[T float32 | float64](x ArrayTest[T], expect []T, epsilon float64) ArrayTest[T] {
+	x.t.Helper()
+
+	x.checkElementwise(expect, func(a, e T) (bool, string) {
+		ok, rel := inEpsilon(float64(a), float64(e), epsilon)
+		return ok, fmt.Sprintf("relative error %g", rel)
+	}, fmt.Sprintf("relative epsilon %g", epsilon))
+
+	return x
+}
+
+func (x ArrayTest[T]) checkElementwise(expect []T, within func(a, e T) (bool, string), tolerance string) {
+	x.t.Helper()
+
+	if len(x.actual) != len(expect) {
+		msg := fmt.Sprintf("array length is not matched, got %d, want %d", len(x.actual), len(expect))
+		x.t.Error(formatErrorMessage(x.description, msg))
+		return
+	}
+
+	var diffs []string
+	for i := range x.actual {
+		if ok, d := within(x.actual[i], expect[i]); !ok {
+			diffs = append(diffs, fmt.Sprintf("  [%d] got %+v, want %+v (%s)", i, x.actual[i], expect[i], d))
+		}
+	}
+
+	if len(diffs) > 0 {
+		msg := fmt.Sprintf("%d elements are out of %s\n", len(diffs), tolerance) + strings.Join(diffs, "\n")
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+}
+
+func inEpsilon(actual, expected, epsilon float64) (bool, float64) {
+	if expected == 0 {
+		return actual == 0, math.Abs(actual)
+	}
+	rel := math.Abs(actual-expected) / math.Abs(expected)
+	return rel <= epsilon, rel
+}
+
+// inULP returns true if distance between actual and expected in ULP is n or less. The distance is returned as string because it can be "NaN".
+func inULP[T number](actual, expected T, n uint64) (bool, string) {
+	var d uint64
+	switch a := any(actual).(type) {
+	case float64:
+		e := any(expected).(float64)
+		if math.IsNaN(a) || math.IsNaN(e) {
+			return false, "NaN"
+		}
+		d = ulpDistance(orderedFloat64(a), orderedFloat64(e))
+	case float32:
+		e := any(expected).(float32)
+		if math.IsNaN(float64(a)) || math.IsNaN(float64(e)) {
+			return false, "NaN"
+		}
+		d = ulpDistance(orderedFloat32(a), orderedFloat32(e))
+	default:
+		lo, hi := actual, expected
+		if hi < lo {
+			lo, hi = hi, lo
+		}
+		// Subtract in 64 bits so that the distance of narrow signed types does not overflow. Wrap-around of uint64 keeps the result correct even for full range of int64.
+		if isUnsigned[T]() {
+			d = uint64(hi) - uint64(lo)
+		} else {
+			d = uint64(int64(hi)) - uint64(int64(lo))
+		}
+	}
+
+	return d <= n, fmt.Sprintf("%d", d)
+}
+
+// orderedFloat64 maps float bits to int64 so that the order of integers matches the order of floats and adjacent floats are adjacent integers. +0 and -0 are mapped to the same value.
+func orderedFloat64(f float64) int64 {
+	bits := int64(math.Float64bits(f))
+	if bits < 0 {
+		return math.MinInt64 - bits
+	}
+	return bits
+}
+
+func orderedFloat32(f float32) int64 {
+	bits := int32(math.Float32bits(f))
+	if bits < 0 {
+		return int64(math.MinInt32 - bits)
+	}
+	return int64(bits)
+}
+
+func ulpDistance(a, b int64) uint64 {
+	if a < b {
+		return uint64(b) - uint64(a)
+	}
+	return uint64(a) - uint64(b)
+}
+
+// Between checks if actual value is min or greater and max or less.
+//
+//	gt.Number(t, uint8(5)).Between(1, 10)  // Pass
+//	gt.Number(t, uint8(10)).Between(1, 10) // Pass
+//	gt.Number(t, uint8(11)).Between(1, 10) // Fail
+func (x NumberTest[T]) Between(min, max T) NumberTest[T] {
+	x.t.Helper()
+	if !(min <= x.actual && x.actual <= max) {
+		msg := fmt.Sprintf("got %+v, want between %+v and %+v (inclusive)", x.actual, min, max)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// BetweenExclusive checks if actual value is greater than min and less than max.
+//
+//	gt.Number(t, 5).BetweenExclusive(1, 10)  // Pass
+//	gt.Number(t, 10).BetweenExclusive(1, 10) // Fail
+func (x NumberTest[T]) BetweenExclusive(min, max T) NumberTest[T] {
+	x.t.Helper()
+	if !(min < x.actual && x.actual < max) {
+		msg := fmt.Sprintf("got %+v, want between %+v and %+v (exclusive)", x.actual, min, max)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Positive checks if actual value is greater than zero.
+//
+//	gt.Number(t, 1).Positive() // Pass
+//	gt.Number(t, 0).Positive() // Fail
+func (x NumberTest[T]) Positive() NumberTest[T] {
+	x.t.Helper()
+	if !(0 < x.actual) {
+		msg := fmt.Sprintf("got %+v, want positive number", x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Negative checks if actual value is less than zero. It always fails for unsigned types because they can not be negative. A wrapped around value (e.g. uint8(0) - 1) is reported with the note.
+//
+//	gt.Number(t, -1).Negative() // Pass
+//	gt.Number(t, 0).Negative()  // Fail
+func (x NumberTest[T]) Negative() NumberTest[T] {
+	x.t.Helper()
+	if !(x.actual < 0) {
+		msg := fmt.Sprintf("got %+v, want negative number", x.actual)
+		if isUnsigned[T]() {
+			msg += fmt.Sprintf(" (%T is unsigned and can not be negative; a negative result wraps around to a large value)", x.actual)
+		}
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Zero checks if actual value is zero.
+//
+//	gt.Number(t, 0).Zero() // Pass
+func (x NumberTest[T]) Zero() NumberTest[T] {
+	x.t.Helper()
+	if x.actual != 0 {
+		msg := fmt.Sprintf("got %+v, want zero", x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// NotZero checks if actual value is not zero.
+//
+//	gt.Number(t, 1).NotZero() // Pass
+func (x NumberTest[T]) NotZero() NumberTest[T] {
+	x.t.Helper()
+	if x.actual == 0 {
+		msg := "got 0, want non-zero number"
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// MultipleOf checks if actual value is a multiple of n. For float types, math.Mod is used to compute remainder. If n is zero, test will fail.
+//
+//	gt.Number(t, 12).MultipleOf(4) // Pass
+//	gt.Number(t, 12).MultipleOf(5) // Fail
+func (x NumberTest[T]) MultipleOf(n T) NumberTest[T] {
+	x.t.Helper()
+	if n == 0 {
+		x.t.Error(formatErrorMessage(x.description, "divisor of MultipleOf must not be zero"))
+		return x
+	}
+
+	if r := remainder(x.actual, n); r != 0 {
+		msg := fmt.Sprintf("got %+v, want multiple of %+v (remainder: %+v)", x.actual, n, r)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Even checks if actual value is an even integer. For float types, actual must be an integer value.
+//
+//	gt.Number(t, 4).Even() // Pass
+//	gt.Number(t, 3).Even() // Fail
+func (x NumberTest[T]) Even() NumberTest[T] {
+	x.t.Helper()
+	if remainder(x.actual, 2) != 0 {
+		msg := fmt.Sprintf("got %+v, want even number", x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Odd checks if actual value is an odd integer. For float types, actual must be an integer value.
+//
+//	gt.Number(t, 3).Odd()  // Pass
+//	gt.Number(t, -3).Odd() // Pass
+//	gt.Number(t, 4).Odd()  // Fail
+func (x NumberTest[T]) Odd() NumberTest[T] {
+	x.t.Helper()
+	if r := remainder(x.actual, 2); r != 1 && -r != 1 {
+		msg := fmt.Sprintf("got %+v, want odd number", x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// AddNoOverflow checks if a + b does not overflow (wrap around) T, and provides NumberTest of the result. The result is tested even if it overflows.
+//
+//	gt.AddNoOverflow(t, uint8(200), 50).Equal(250) // Pass
+//	gt.AddNoOverflow(t, uint8(200), 56)            // Fail, wrapped to 0
+//	gt.AddNoOverflow(t, int8(-100), -29)           // Fail, wrapped to 127
+func AddNoOverflow[T integer](t testing.TB, a, b T) NumberTest[T] {
+	t.Helper()
+	sum := a + b
+	// sum moves from a in the same direction as sign of b unless it wraps around.
+	if (b >= 0 && sum < a) || (b < 0 && sum > a) {
+		t.Errorf("%+v + %+v overflows %T, got %+v", a, b, a, sum)
+	}
+	return Number(t, sum)
+}
+
+// SubNoOverflow checks if a - b does not overflow (wrap around) T, and provides NumberTest of the result. It detects that subtraction of unsigned values turns a negative result into a large value.
+//
+//	gt.SubNoOverflow(t, uint8(2), 1).Equal(1) // Pass
+//	gt.SubNoOverflow(t, uint8(1), 2)          // Fail, wrapped to 255
+func SubNoOverflow[T integer](t testing.TB, a, b T) NumberTest[T] {
+	t.Helper()
+	diff := a - b
+	if (b >= 0 && diff > a) || (b < 0 && diff < a) {
+		t.Errorf("%+v - %+v overflows %T, got %+v", a, b, a, diff)
+	}
+	return Number(t, diff)
+}
+
+func isUnsigned[T number]() bool {
+	var zero T
+	return zero-1 > 0
+}
+
+// remainder returns a % n. It converts values to int64 or uint64 so that % can be used for generic integer types without overflow of unsigned values.
+func remainder[T number](a, n T) T {
+	switch v := any(a).(type) {
+	case float64:
+		return T(math.Mod(v, float64(n)))
+	case float32:
+		return T(math.Mod(float64(v), float64(n)))
+	}
+
+	if isUnsigned[T]() {
+		return T(uint64(a) % uint64(n))
+	}
+	return T(int64(a) % int64(n))
+}
@@ -2,6 +2,7 @@ package gt_test
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/m-mizutani/gt"
@@ -97,6 +98,96 @@ func numberTest[T number](t *testing.T) {
 				check:  func(n gt.NumberTest[T]) { n.LessOrEqual(4) },
 				pass:   false,
 			},
+			"Between_pass": {
+				actual: 10,
+				check:  func(n gt.NumberTest[T]) { n.Between(1, 10) },
+				pass:   true,
+			},
+			"Between_fail": {
+				actual: 11,
+				check:  func(n gt.NumberTest[T]) { n.Between(1, 10) },
+				pass:   false,
+			},
+			"BetweenExclusive_pass": {
+				actual: 9,
+				check:  func(n gt.NumberTest[T]) { n.BetweenExclusive(1, 10) },
+				pass:   true,
+			},
+			"BetweenExclusive_fail": {
+				actual: 10,
+				check:  func(n gt.NumberTest[T]) { n.BetweenExclusive(1, 10) },
+				pass:   false,
+			},
+			"Positive_pass": {
+				actual: 1,
+				check:  func(n gt.NumberTest[T]) { n.Positive() },
+				pass:   true,
+			},
+			"Positive_fail": {
+				actual: 0,
+				check:  func(n gt.NumberTest[T]) { n.Positive() },
+				pass:   false,
+			},
+			"Negative_fail": {
+				actual: 0,
+				check:  func(n gt.NumberTest[T]) { n.Negative() },
+				pass:   false,
+			},
+			"Zero_pass": {
+				actual: 0,
+				check:  func(n gt.NumberTest[T]) { n.Zero() },
+				pass:   true,
+			},
+			"Zero_fail": {
+				actual: 1,
+				check:  func(n gt.NumberTest[T]) { n.Zero() },
+				pass:   false,
+			},
+			"NotZero_pass": {
+				actual: 1,
+				check:  func(n gt.NumberTest[T]) { n.NotZero() },
+				pass:   true,
+			},
+			"NotZero_fail": {
+				actual: 0,
+				check:  func(n gt.NumberTest[T]) { n.NotZero() },
+				pass:   false,
+			},
+			"MultipleOf_pass": {
+				actual: 12,
+				check:  func(n gt.NumberTest[T]) { n.MultipleOf(4) },
+				pass:   true,
+			},
+			"MultipleOf_fail": {
+				actual: 12,
+				check:  func(n gt.NumberTest[T]) { n.MultipleOf(5) },
+				pass:   false,
+			},
+			"MultipleOf_fail_with_zero": {
+				actual: 12,
+				check:  func(n gt.NumberTest[T]) { n.MultipleOf(0) },
+				pass:   false,
+			},
+			"Even_pass": {
+				actual: 4,
+				check:  func(n gt.NumberTest[T]) { n.Even() },
+				pass:   true,
+			},
+			"Even_fail": {
+				actual: 3,
+				check:  func(n gt.NumberTest[T]) { n.Even() },
+				pass:   false,
+			},
+			"Odd_pass": {
+				actual: 3,
+				check:  func(n gt.NumberTest[T]) { n.Odd() },
+				pass:   true,
+			},
+			"Odd_fail": {
+				actual: 4,
+				check:  func(n gt.NumberTest[T]) { n.Odd() },
+				pass:   false,
+			},
 		}
 
 		pass := func(v bool) string {
@@ -119,3 +210,248 @@ func numberTest[T number](t *testing.T) {
 		}
 	})
 }
+
+func TestNumberApprox(t *testing.T) {
+	testCases := map[string]struct {
+		test func(t testing.TB)
+		pass bool
+	}{
+		"InDelta pass": {
+			test: func(t testing.TB) { gt.Number(t, 0.1+0.2).InDelta(0.3, 1e-9) },
+			pass: true,
+		},
+		"InDelta fail": {
+			test: func(t testing.TB) { gt.Number(t, 0.31).InDelta(0.3, 1e-9) },
+			pass: false,
+		},
+		"InDelta with int": {
+			test: func(t testing.TB) { gt.Number(t, 10).InDelta(12, 2) },
+			pass: true,
+		},
+		"InDelta fail with NaN": {
+			test: func(t testing.TB) { gt.Number(t, math.NaN()).InDelta(0, math.Inf(1)) },
+			pass: false,
+		},
+		"InEpsilon pass": {
+			test: func(t testing.TB) { gt.Number(t, 101.0).InEpsilon(100, 0.01) },
+			pass: true,
+		},
+		"InEpsilon fail": {
+			test: func(t testing.TB) { gt.Number(t, 102.0).InEpsilon(100, 0.01) },
+			pass: false,
+		},
+		"InEpsilon with zero expected": {
+			test: func(t testing.TB) { gt.Number(t, 0.0).InEpsilon(0, 0.01) },
+			pass: true,
+		},
+		"InEpsilon fail with zero expected": {
+			test: func(t testing.TB) { gt.Number(t, 1e-12).InEpsilon(0, 0.01) },
+			pass: false,
+		},
+		"InULP pass": {
+			test: func(t testing.TB) { gt.Number(t, math.Nextafter(1, 2)).InULP(1, 1) },
+			pass: true,
+		},
+		"InULP fail": {
+			test: func(t testing.TB) { gt.Number(t, math.Nextafter(math.Nextafter(1, 2), 2)).InULP(1, 1) },
+			pass: false,
+		},
+		"InULP across zero": {
+			test: func(t testing.TB) {
+				gt.Number(t, math.SmallestNonzeroFloat64).InULP(-math.SmallestNonzeroFloat64, 2)
+			},
+			pass: true,
+		},
+		"InULP with float32": {
+			test: func(t testing.TB) {
+				gt.Number(t, math.Nextafter32(1, 2)).InULP(1, 1)
+			},
+			pass: true,
+		},
+		"InULP with int": {
+			test: func(t testing.TB) { gt.Number(t, uint8(3)).InULP(5, 1) },
+			pass: false,
+		},
+		"InULP with int8 full range pass": {
+			test: func(t testing.TB) { gt.Number(t, int8(-128)).InULP(127, 300) },
+			pass: true,
+		},
+		"InULP with int8 full range fail": {
+			test: func(t testing.TB) { gt.Number(t, int8(127)).InULP(-128, 254) },
+			pass: false,
+		},
+		"InULP with int64 full range": {
+			test: func(t testing.TB) { gt.Number(t, int64(math.MinInt64)).InULP(math.MaxInt64, math.MaxUint64) },
+			pass: true,
+		},
+		"IsNaN pass": {
+			test: func(t testing.TB) { gt.Number(t, math.NaN()).IsNaN() },
+			pass: true,
+		},
+		"IsNaN fail": {
+			test: func(t testing.TB) { gt.Number(t, 1.0).IsNaN() },
+			pass: false,
+		},
+		"IsInf pass": {
+			test: func(t testing.TB) { gt.Number(t, math.Inf(-1)).IsInf(0).IsInf(-1) },
+			pass: true,
+		},
+		"IsInf fail with sign": {
+			test: func(t testing.TB) { gt.Number(t, math.Inf(1)).IsInf(-1) },
+			pass: false,
+		},
+		"IsFinite pass": {
+			test: func(t testing.TB) { gt.Number(t, 1.5).IsFinite() },
+			pass: true,
+		},
+		"IsFinite fail": {
+			test: func(t testing.TB) { gt.Number(t, math.Inf(1)).IsFinite() },
+			pass: false,
+		},
+		"ArrayInDelta pass": {
+			test: func(t testing.TB) {
+				gt.ArrayInDelta(gt.Array(t, []float64{0.1 + 0.2, 1.0}), []float64{0.3, 1.0}, 1e-9)
+			},
+			pass: true,
+		},
+		"ArrayInDelta fail": {
+			test: func(t testing.TB) {
+				gt.ArrayInDelta(gt.Array(t, []float64{0.3, 1.1}), []float64{0.3, 1.0}, 1e-9)
+			},
+			pass: false,
+		},
+		"ArrayInDelta fail with length": {
+			test: func(t testing.TB) {
+				gt.ArrayInDelta(gt.Array(t, []float32{0.3}), []float32{0.3, 1.0}, 1e-6)
+			},
+			pass: false,
+		},
+		"ArrayInEpsilon pass": {
+			test: func(t testing.TB) {
+				gt.ArrayInEpsilon(gt.Array(t, []float64{101, 200}), []float64{100, 200}, 0.01)
+			},
+			pass: true,
+		},
+		"ArrayInEpsilon fail": {
+			test: func(t testing.TB) {
+				gt.ArrayInEpsilon(gt.Array(t, []float64{101, 210}), []float64{100, 200}, 0.01)
+			},
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(r)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestNumberInDeltaMessage(t *testing.T) {
+	r := newRecorder()
+	gt.Number(r, 0.5).InDelta(0.3, 0.1)
+	gt.Number(t, r.errs).Equal(1)
+	gt.String(t, r.msgs[0]).Contains("actual delta: 0.2")
+
+	r = newRecorder()
+	gt.ArrayInDelta(gt.Array(r, []float64{1, 2, 3}), []float64{1, 2.5, 4}, 0.1)
+	gt.Number(t, r.errs).Equal(1)
+	gt.String(t, r.msgs[0]).Contains("2 elements are out of delta 0.1").Contains("[1] got 2, want 2.5 (delta 0.5)")
+}
+
+func TestNumberSignAndParity(t *testing.T) {
+	testCases := map[string]struct {
+		test func(t testing.TB)
+		pass bool
+	}{
+		"Negative pass": {
+			test: func(t testing.TB) { gt.Number(t, -1).Negative() },
+			pass: true,
+		},
+		"Negative fail with unsigned": {
+			test: func(t testing.TB) { gt.Number(t, uint8(255)).Negative() },
+			pass: false,
+		},
+		"Odd pass with negative": {
+			test: func(t testing.TB) { gt.Number(t, -3).Odd() },
+			pass: true,
+		},
+		"Even pass with negative": {
+			test: func(t testing.TB) { gt.Number(t, int8(-4)).Even() },
+			pass: true,
+		},
+		"Even fail with fractional float": {
+			test: func(t testing.TB) { gt.Number(t, 4.5).Even() },
+			pass: false,
+		},
+		"Odd fail with fractional float": {
+			test: func(t testing.TB) { gt.Number(t, 3.5).Odd() },
+			pass: false,
+		},
+		"MultipleOf pass with float": {
+			test: func(t testing.TB) { gt.Number(t, 7.5).MultipleOf(2.5) },
+			pass: true,
+		},
+		"MultipleOf pass with large uint64": {
+			test: func(t testing.TB) { gt.Number(t, uint64(math.MaxUint64)).MultipleOf(5) },
+			pass: true,
+		},
+		"AddNoOverflow pass": {
+			test: func(t testing.TB) { gt.AddNoOverflow(t, uint8(200), 55).Equal(255) },
+			pass: true,
+		},
+		"AddNoOverflow pass with negative": {
+			test: func(t testing.TB) { gt.AddNoOverflow(t, int8(-100), -28).Equal(-128) },
+			pass: true,
+		},
+		"AddNoOverflow fail with wrapped uint8": {
+			test: func(t testing.TB) { gt.AddNoOverflow(t, uint8(200), 56) },
+			pass: false,
+		},
+		"AddNoOverflow fail with wrapped int8": {
+			test: func(t testing.TB) { gt.AddNoOverflow(t, int8(-100), -29) },
+			pass: false,
+		},
+		"AddNoOverflow fail with wrapped int64": {
+			test: func(t testing.TB) { gt.AddNoOverflow(t, int64(math.MaxInt64), 1) },
+			pass: false,
+		},
+		"SubNoOverflow pass": {
+			test: func(t testing.TB) { gt.SubNoOverflow(t, uint8(2), 1).Equal(1) },
+			pass: true,
+		},
+		"SubNoOverflow pass with negative": {
+			test: func(t testing.TB) { gt.SubNoOverflow(t, int8(-1), -128).Equal(127) },
+			pass: true,
+		},
+		"SubNoOverflow fail with wrapped uint8": {
+			test: func(t testing.TB) { gt.SubNoOverflow(t, uint8(1), 2) },
+			pass: false,
+		},
+		"SubNoOverflow fail with wrapped int8": {
+			test: func(t testing.TB) { gt.SubNoOverflow(t, int8(0), -128) },
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(r)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestNumberNegativeUnsignedMessage(t *testing.T) {
+	r := newRecorder()
+	gt.Number(r, uint(3)).Negative()
+	gt.Number(t, r.errs).Equal(1)
+	gt.String(t, r.msgs[0]).Contains("uint is unsigned")
+}
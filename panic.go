@@ -0,0 +1,213 @@
+package gt
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"testing"
+)
+
+type PanicTest struct {
+	TestMeta
+	panicked  bool
+	recovered any
+	stack     string
+}
+
+// capturePanic calls f and returns recovered value and stack trace if f panics.
+func capturePanic(f func()) (panicked bool, recovered any, stack string) {
+	completed := false
+	func() {
+		defer func() {
+			if completed {
+				return
+			}
+			// recover() returns nil also for panic(nil) before Go 1.21, so a panic is detected by whether this function returns, not by the recovered value.
+			recovered = recover()
+			stack = string(debug.Stack())
+		}()
+		f()
+		completed = true
+	}()
+	// Reached only if f returned or panicked. If f exits by runtime.Goexit (e.g. t.FailNow()), the goroutine ends before here.
+	panicked = !completed
+	return
+}
+
+// Panic calls f and checks if f panics. If f does not panic, test will fail. PanicTest provides methods to inspect the recovered value.
+//
+//	gt.Panic(t, func() {
+//		panic("boom")
+//	}).Equal("boom") // Pass
+//
+//	gt.Panic(t, func() {}) // Fail
+func Panic(t testing.TB, f func()) PanicTest {
+	t.Helper()
+	panicked, recovered, stack := capturePanic(f)
+	if !panicked {
+		t.Error("expected panic, but not panicked")
+	}
+	return PanicTest{
+		TestMeta:  TestMeta{t: t},
+		panicked:  panicked,
+		recovered: recovered,
+		stack:     stack,
+	}
+}
+
+type NoPanicTest struct {
+	t        testing.TB
+	panicked bool
+}
+
+// NoPanic calls f and checks if f does not panic. If f panics, the panic is recovered and test will fail with the recovered value and stack trace instead of crashing the test binary.
+//
+//	gt.NoPanic(t, func() {
+//		doSomething()
+//	}).Required()
+func NoPanic(t testing.TB, f func()) NoPanicTest {
+	t.Helper()
+	panicked, recovered, stack := capturePanic(f)
+	if panicked {
+		t.Errorf("expected no panic, but panicked: %+v\n%s", recovered, stack)
+	}
+	return NoPanicTest{
+		t:        t,
+		panicked: panicked,
+	}
+}
+
+// Required stops test immediately by t.FailNow() if f has panicked.
+func (x NoPanicTest) Required() {
+	x.t.Helper()
+	if x.panicked {
+		x.t.FailNow()
+	}
+}
+
+// Describe sets a description for the test. The description will be displayed when the test fails.
+func (x PanicTest) Describe(description string) PanicTest {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when the test fails.
+func (x PanicTest) Describef(format string, args ...any) PanicTest {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test. If errors has been occurred in previous test, it immediately stop test by t.FailNow().
+func (x PanicTest) Required() PanicTest {
+	x.requiredWithMeta()
+	return x
+}
+
+// Equal checks if recovered value equals with expect. Default evaluation function uses reflect.DeepEqual.
+//
+//	gt.Panic(t, func() { panic("boom") }).Equal("boom") // Pass
+//	gt.Panic(t, func() { panic(1) }).Equal("boom")      // Fail
+func (x PanicTest) Equal(expect any) PanicTest {
+	x.t.Helper()
+	if !x.panicked {
+		return x
+	}
+
+	if !evalCompare(x.t, x.recovered, expect) {
+		msg := "recovered value is not matched\n" + evalDiff(x.t, expect, x.recovered)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Contains checks if formatted recovered value (by fmt.Sprint) contains substr.
+//
+//	gt.Panic(t, func() { panic("index out of range") }).Contains("out of range") // Pass
+func (x PanicTest) Contains(substr string) PanicTest {
+	x.t.Helper()
+	if !x.panicked {
+		return x
+	}
+
+	if msg := fmt.Sprint(x.recovered); !strings.Contains(msg, substr) {
+		msgText := fmt.Sprintf("expected panic message containing %q, but got %q", substr, msg)
+		x.t.Error(formatErrorMessage(x.description, msgText))
+	}
+
+	return x
+}
+
+// Is checks if recovered value is error and matches with expected by errors.Is() function.
+//
+//	gt.Panic(t, func() { panic(ErrInvalid) }).Is(ErrInvalid) // Pass
+func (x PanicTest) Is(expected error) PanicTest {
+	x.t.Helper()
+	if !x.panicked {
+		return x
+	}
+
+	err, ok := x.recovered.(error)
+	if !ok {
+		msg := fmt.Sprintf("expected panic with error, but got %T (%+v)", x.recovered, x.recovered)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	} else if !errors.Is(err, expected) {
+		msg := fmt.Sprintf("expected panic with %v, but got %v", expected, err)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// As checks if recovered value is error and can be extracted to target by errors.As() function. target must be a non-nil pointer to a type that implements error or to any interface type.
+//
+//	var myErr *MyError
+//	gt.Panic(t, func() { panic(&MyError{}) }).As(&myErr) // Pass
+func (x PanicTest) As(target any) PanicTest {
+	x.t.Helper()
+	if !x.panicked {
+		return x
+	}
+
+	err, ok := x.recovered.(error)
+	if !ok {
+		msg := fmt.Sprintf("expected panic with error, but got %T (%+v)", x.recovered, x.recovered)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	} else if !errors.As(err, target) {
+		msg := fmt.Sprintf("expected panic with %T, but got %T", target, err)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// Value calls f with testing.TB and recovered value.
+//
+//	gt.Panic(t, func() { panic(42) }).Value(func(t testing.TB, v any) {
+//		gt.Value(t, v).Equal(42)
+//	})
+func (x PanicTest) Value(f func(t testing.TB, v any)) PanicTest {
+	x.t.Helper()
+	if !x.panicked {
+		return x
+	}
+
+	f(x.t, x.recovered)
+	return x
+}
+
+// Stack calls f with testing.TB and stack trace captured when panic was recovered.
+//
+//	gt.Panic(t, func() { mustParse("") }).Stack(func(t testing.TB, stack string) {
+//		gt.String(t, stack).Contains("mustParse")
+//	})
+func (x PanicTest) Stack(f func(t testing.TB, stack string)) PanicTest {
+	x.t.Helper()
+	if !x.panicked {
+		return x
+	}
+
+	f(x.t, x.stack)
+	return x
+}
@@ -0,0 +1,148 @@
+package gt_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func mustBePositive(n int) int {
+	if n <= 0 {
+		panic(fmt.Sprintf("n must be positive, but %d", n))
+	}
+	return n
+}
+
+func TestPanic(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	testCases := map[string]struct {
+		test func(t testing.TB)
+		pass bool
+	}{
+		"panicked": {
+			test: func(t testing.TB) {
+				gt.Panic(t, func() { mustBePositive(0) })
+			},
+			pass: true,
+		},
+		"not panicked": {
+			test: func(t testing.TB) {
+				gt.Panic(t, func() { mustBePositive(1) })
+			},
+			pass: false,
+		},
+		"panicked with nil": {
+			test: func(t testing.TB) {
+				gt.Panic(t, func() { panic(nil) })
+			},
+			pass: true,
+		},
+		"Equal: pass": {
+			test: func(t testing.TB) {
+				gt.Panic(t, func() { panic(42) }).Equal(42)
+			},
+			pass: true,
+		},
+		"Equal: fail": {
+			test: func(t testing.TB) {
+				gt.Panic(t, func() { panic(42) }).Equal("42")
+			},
+			pass: false,
+		},
+		"Contains: pass": {
+			test: func(t testing.TB) {
+				gt.Panic(t, func() { mustBePositive(-1) }).Contains("must be positive")
+			},
+			pass: true,
+		},
+		"Contains: fail": {
+			test: func(t testing.TB) {
+				gt.Panic(t, func() { mustBePositive(-1) }).Contains("must be negative")
+			},
+			pass: false,
+		},
+		"Is: pass": {
+			test: func(t testing.TB) {
+				gt.Panic(t, func() { panic(fmt.Errorf("wrapped: %w", errBoom)) }).Is(errBoom)
+			},
+			pass: true,
+		},
+		"Is: fail with not error": {
+			test: func(t testing.TB) {
+				gt.Panic(t, func() { panic("boom") }).Is(errBoom)
+			},
+			pass: false,
+		},
+		"As: pass": {
+			test: func(t testing.TB) {
+				var tgt testError
+				gt.Panic(t, func() { panic(testError{N: 1}) }).As(&tgt)
+			},
+			pass: true,
+		},
+		"As: fail": {
+			test: func(t testing.TB) {
+				var tgt testError
+				gt.Panic(t, func() { panic(errBoom) }).As(&tgt)
+			},
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(r)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestPanicStack(t *testing.T) {
+	var called int
+	gt.Panic(t, func() { mustBePositive(0) }).Stack(func(t testing.TB, stack string) {
+		called++
+		gt.String(t, stack).Contains("mustBePositive")
+	}).Value(func(t testing.TB, v any) {
+		called++
+		gt.Value(t, v).Equal(any("n must be positive, but 0"))
+	})
+	if called != 2 {
+		t.Errorf("callbacks should be called, but %d", called)
+	}
+}
+
+func TestNoPanic(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		r := newRecorder()
+		gt.NoPanic(r, func() { mustBePositive(1) }).Required()
+		if r.errs != 0 || r.fails != 0 {
+			t.Error("should pass")
+		}
+	})
+
+	t.Run("fail with panic(nil)", func(t *testing.T) {
+		r := newRecorder()
+		gt.NoPanic(r, func() { panic(nil) })
+		if r.errs != 1 {
+			t.Error("panic(nil) should be reported")
+		}
+	})
+
+	t.Run("fail with panic value and stack", func(t *testing.T) {
+		r := newRecorder()
+		gt.NoPanic(r, func() { mustBePositive(0) }).Required()
+		if r.errs != 1 || r.fails != 1 {
+			t.Fatal("should fail and stop")
+		}
+		if !strings.Contains(r.msgs[0], "n must be positive") || !strings.Contains(r.msgs[0], "mustBePositive") {
+			t.Errorf("unexpected message: %s", r.msgs[0])
+		}
+	})
+}
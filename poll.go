@@ -0,0 +1,181 @@
+package gt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	defaultEventuallyTimeout    = time.Second
+	defaultConsistentlyDuration = 100 * time.Millisecond
+	defaultPollInterval         = 10 * time.Millisecond
+)
+
+type pollConfig struct {
+	timeout  time.Duration
+	interval time.Duration
+}
+
+// PollOption is an option for Eventually and Consistently.
+type PollOption func(cfg *pollConfig)
+
+// PollTimeout sets timeout of Eventually, or duration that condition must be kept in Consistently. Default is 1 second for Eventually and 100 milliseconds for Consistently.
+func PollTimeout(d time.Duration) PollOption {
+	return func(cfg *pollConfig) {
+		cfg.timeout = d
+	}
+}
+
+// PollInterval sets interval between attempts of Eventually and Consistently. Default is 10 milliseconds.
+func PollInterval(d time.Duration) PollOption {
+	return func(cfg *pollConfig) {
+		cfg.interval = d
+	}
+}
+
+type PollTest struct {
+	TestMeta
+}
+
+// Eventually calls f repeatedly until f passes all assertions or timeout. Failures in f are recorded and only failures of the last attempt are reported to t when timed out. t.FailNow() (e.g. by Required()) in f stops only the current attempt. If an attempt is skipped by t.Skip(), Eventually stops without failure. Cleanup functions registered in f are called at the end of each attempt. If an attempt does not return within timeout (e.g. blocked by channel receive), Eventually fails without waiting for it. The goroutine running the attempt is abandoned and keeps running in background.
+//
+//	gt.Eventually(t, func(t testing.TB) {
+//		gt.Value(t, server.Status()).Equal("ready")
+//	}, gt.PollTimeout(5*time.Second), gt.PollInterval(100*time.Millisecond)).Required()
+func Eventually(t testing.TB, f func(t testing.TB), options ...PollOption) PollTest {
+	t.Helper()
+	cfg := newPollConfig(defaultEventuallyTimeout, options)
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		r, finished := runAttempt(t, f, start.Add(cfg.timeout))
+		if !finished {
+			t.Error(attemptTimeoutMessage(r, attempt, cfg.timeout))
+			return PollTest{TestMeta: TestMeta{t: t}}
+		}
+		if !r.Failed() {
+			if r.Skipped() {
+				r.replayLogs(t)
+				t.Logf("skipped at attempt %d", attempt)
+			}
+			return PollTest{TestMeta: TestMeta{t: t}}
+		}
+
+		if elapsed := time.Since(start); elapsed+cfg.interval > cfg.timeout {
+			r.replayLogs(t)
+			msg := fmt.Sprintf("condition is not satisfied within %v (%d attempts), last attempt failed:\n%s", cfg.timeout, attempt, formatRecordedErrors(r.errors()))
+			t.Error(msg)
+			return PollTest{TestMeta: TestMeta{t: t}}
+		}
+		time.Sleep(cfg.interval)
+	}
+}
+
+// Consistently calls f repeatedly for duration specified by PollTimeout and checks f passes all assertions in every attempt. If an attempt fails, Consistently stops and reports failures of the attempt to t. If an attempt is skipped by t.Skip(), Consistently stops without failure. An attempt that does not return within the duration is also failure, and its goroutine is abandoned in the same way as Eventually.
+//
+//	gt.Consistently(t, func(t testing.TB) {
+//		gt.Number(t, queue.Len()).Equal(0)
+//	}, gt.PollTimeout(500*time.Millisecond))
+func Consistently(t testing.TB, f func(t testing.TB), options ...PollOption) PollTest {
+	t.Helper()
+	cfg := newPollConfig(defaultConsistentlyDuration, options)
+
+	start := time.Now()
+	for attempt := 1; ; attempt++ {
+		r, finished := runAttempt(t, f, start.Add(cfg.timeout))
+		if !finished {
+			t.Error(attemptTimeoutMessage(r, attempt, cfg.timeout))
+			return PollTest{TestMeta: TestMeta{t: t}}
+		}
+		if r.Failed() {
+			r.replayLogs(t)
+			msg := fmt.Sprintf("condition is not kept at attempt %d (after %v):\n%s", attempt, time.Since(start).Round(time.Millisecond), formatRecordedErrors(r.errors()))
+			t.Error(msg)
+			return PollTest{TestMeta: TestMeta{t: t}}
+		}
+		if r.Skipped() {
+			r.replayLogs(t)
+			t.Logf("skipped at attempt %d", attempt)
+			return PollTest{TestMeta: TestMeta{t: t}}
+		}
+
+		if elapsed := time.Since(start); elapsed+cfg.interval > cfg.timeout {
+			return PollTest{TestMeta: TestMeta{t: t}}
+		}
+		time.Sleep(cfg.interval)
+	}
+}
+
+// runAttempt runs f with recordTB and waits until f exits or deadline. If deadline comes first, finished is false and the goroutine running f is abandoned. It keeps running until f returns, but its failures are not reported anymore.
+func runAttempt(t testing.TB, f func(t testing.TB), deadline time.Time) (r *recordTB, finished bool) {
+	r, done := startRecorded(t, f)
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	select {
+	case <-done:
+		return r, true
+	case <-timer.C:
+		select {
+		case <-done:
+			return r, true
+		default:
+			return r, false
+		}
+	}
+}
+
+func attemptTimeoutMessage(r *recordTB, attempt int, timeout time.Duration) string {
+	msg := fmt.Sprintf("attempt %d did not finish within %v", attempt, timeout)
+	if errs := r.errors(); len(errs) > 0 {
+		msg += ", recorded failures so far:\n" + formatRecordedErrors(errs)
+	}
+	return msg
+}
+
+func newPollConfig(timeout time.Duration, options []PollOption) *pollConfig {
+	cfg := &pollConfig{
+		timeout:  timeout,
+		interval: defaultPollInterval,
+	}
+	for _, opt := range options {
+		opt(cfg)
+	}
+	return cfg
+}
+
+func formatRecordedErrors(msgs []string) string {
+	if len(msgs) == 0 {
+		return "  (failed without message)"
+	}
+
+	lines := make([]string, len(msgs))
+	for i, msg := range msgs {
+		lines[i] = "  " + strings.ReplaceAll(msg, "\n", "\n  ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Describe sets a description for the test. The description will be displayed when Required() stops the test.
+func (x PollTest) Describe(description string) PollTest {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when Required() stops the test.
+func (x PollTest) Describef(format string, args ...any) PollTest {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test. If errors has been occurred in previous test (e.g. Eventually timed out), it immediately stop test by t.FailNow().
+//
+//	gt.Eventually(t, func(t testing.TB) {
+//		gt.NoError(t, ping())
+//	}).Describe("server should be up").Required()
+func (x PollTest) Required() PollTest {
+	x.requiredWithMeta()
+	return x
+}
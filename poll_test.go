@@ -0,0 +1,172 @@
+package gt_test
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestEventually(t *testing.T) {
+	t.Run("pass after some attempts", func(t *testing.T) {
+		var count int32
+		r := newRecorder()
+		gt.Eventually(r, func(t testing.TB) {
+			gt.Number(t, atomic.AddInt32(&count, 1)).GreaterOrEqual(3)
+		}, gt.PollInterval(time.Millisecond))
+
+		if r.errs != 0 {
+			t.Errorf("should pass, but %v", r.msgs)
+		}
+		if count != 3 {
+			t.Errorf("should be called 3 times, but %d", count)
+		}
+	})
+
+	t.Run("fail with only last attempt", func(t *testing.T) {
+		var count int32
+		r := newRecorder()
+		gt.Eventually(r, func(t testing.TB) {
+			n := atomic.AddInt32(&count, 1)
+			gt.Number(t, n).Describef("attempt %d", n).Equal(0)
+		}, gt.PollTimeout(30*time.Millisecond), gt.PollInterval(5*time.Millisecond))
+
+		if r.errs != 1 {
+			t.Fatalf("should fail once, but %d", r.errs)
+		}
+		if !strings.Contains(r.msgs[0], "not satisfied within") {
+			t.Errorf("unexpected message: %s", r.msgs[0])
+		}
+		if strings.Contains(r.msgs[0], "attempt 1\n") {
+			t.Errorf("failures of previous attempts should not be reported: %s", r.msgs[0])
+		}
+	})
+
+	t.Run("Required in block stops only the attempt", func(t *testing.T) {
+		var count int32
+		r := newRecorder()
+		gt.Eventually(r, func(t testing.TB) {
+			n := atomic.AddInt32(&count, 1)
+			gt.Number(t, n).Greater(1).Required()
+			gt.Number(t, n).Equal(2)
+		}, gt.PollInterval(time.Millisecond))
+
+		if r.errs != 0 {
+			t.Errorf("should pass, but %v", r.msgs)
+		}
+	})
+
+	t.Run("Required stops test after timeout", func(t *testing.T) {
+		r := newRecorder()
+		gt.Eventually(r, func(t testing.TB) {
+			t.Error("never")
+		}, gt.PollTimeout(10*time.Millisecond), gt.PollInterval(time.Millisecond)).Required()
+
+		if r.fails != 1 {
+			t.Error("Required should call FailNow")
+		}
+	})
+
+	t.Run("Skip stops polling without failure", func(t *testing.T) {
+		var count int32
+		r := newRecorder()
+		gt.Eventually(r, func(t testing.TB) {
+			atomic.AddInt32(&count, 1)
+			t.Skip("not supported")
+		}, gt.PollInterval(time.Millisecond))
+
+		if r.errs != 0 || count != 1 {
+			t.Errorf("should stop at first attempt without failure, errs=%d count=%d", r.errs, count)
+		}
+	})
+
+	t.Run("blocked attempt fails by timeout", func(t *testing.T) {
+		block := make(chan struct{})
+		defer close(block)
+
+		r := newRecorder()
+		start := time.Now()
+		gt.Eventually(r, func(t testing.TB) {
+			t.Error("not yet")
+			<-block
+		}, gt.PollTimeout(30*time.Millisecond), gt.PollInterval(time.Millisecond))
+
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("should return soon after timeout, but %v", elapsed)
+		}
+		if r.errs != 1 {
+			t.Fatalf("should fail once, but %d", r.errs)
+		}
+		if !strings.Contains(r.msgs[0], "attempt 1 did not finish within 30ms") || !strings.Contains(r.msgs[0], "not yet") {
+			t.Errorf("unexpected message: %s", r.msgs[0])
+		}
+	})
+
+	t.Run("cleanup is called for each attempt", func(t *testing.T) {
+		var count, cleaned int32
+		r := newRecorder()
+		gt.Eventually(r, func(t testing.TB) {
+			t.Cleanup(func() { atomic.AddInt32(&cleaned, 1) })
+			gt.Number(t, atomic.AddInt32(&count, 1)).GreaterOrEqual(3)
+		}, gt.PollInterval(time.Millisecond))
+
+		if cleaned != 3 {
+			t.Errorf("cleanup should be called at end of every attempt, but %d", cleaned)
+		}
+		if len(r.cleanups) != 0 {
+			t.Error("cleanup should not be registered to outer testing.TB")
+		}
+	})
+}
+
+func TestConsistently(t *testing.T) {
+	t.Run("pass", func(t *testing.T) {
+		var count int32
+		r := newRecorder()
+		gt.Consistently(r, func(t testing.TB) {
+			atomic.AddInt32(&count, 1)
+			gt.Bool(t, true).True()
+		}, gt.PollTimeout(20*time.Millisecond), gt.PollInterval(time.Millisecond))
+
+		if r.errs != 0 {
+			t.Errorf("should pass, but %v", r.msgs)
+		}
+		if count < 2 {
+			t.Errorf("should be called repeatedly, but %d", count)
+		}
+	})
+
+	t.Run("fail when condition is broken", func(t *testing.T) {
+		var count int32
+		r := newRecorder()
+		gt.Consistently(r, func(t testing.TB) {
+			gt.Number(t, atomic.AddInt32(&count, 1)).Less(3)
+		}, gt.PollTimeout(time.Second), gt.PollInterval(time.Millisecond))
+
+		if r.errs != 1 {
+			t.Fatalf("should fail once, but %d", r.errs)
+		}
+		if !strings.Contains(r.msgs[0], "attempt 3") {
+			t.Errorf("unexpected message: %s", r.msgs[0])
+		}
+	})
+
+	t.Run("blocked attempt fails by timeout", func(t *testing.T) {
+		block := make(chan struct{})
+		defer close(block)
+
+		var count int32
+		r := newRecorder()
+		gt.Consistently(r, func(t testing.TB) {
+			if atomic.AddInt32(&count, 1) == 2 {
+				<-block
+			}
+		}, gt.PollTimeout(30*time.Millisecond), gt.PollInterval(time.Millisecond))
+
+		if r.errs != 1 || !strings.Contains(r.msgs[0], "attempt 2 did not finish within 30ms") {
+			t.Errorf("unexpected result: %v", r.msgs)
+		}
+	})
+}
@@ -1,6 +1,9 @@
 package gt
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 type Return1Test[T1 any] struct {
 	r1  T1
@@ -34,16 +37,56 @@ func (x Return1Test[T1]) Error(t testing.TB) ErrorTest {
 	return Error(t, x.err)
 }
 
+// ErrorAndZero check if the function returned error and the other returned value is zero value. It provides ErrorTest
+//
+//	f := func() (*User, error) {
+//		return nil, errors.New("not found")
+//	}
+//	gt.Return1(f()).ErrorAndZero(t).Contains("not found") // Pass
+func (x Return1Test[T1]) ErrorAndZero(t testing.TB) ErrorTest {
+	t.Helper()
+	checkZeroValues(t, x.r1)
+	return x.Error(t)
+}
+
 // NoError check if the function returned no error. If error is not nil, it will fail. If error is nil, it provides 1st returned value.
 func (x Return1Test[T1]) NoError(t testing.TB) T1 {
 	t.Helper()
 	if x.err != nil {
-		t.Errorf("%v", "got errored, but should not get error\n"+DumpError(x.err))
+		t.Errorf("%v", "got errored, but should not get error\n"+dumpError(t, x.err))
 		t.FailNow()
 	}
 	return x.r1
 }
 
+// Value check if the function returned no error in the same way as NoError, and provides ValueTest of 1st returned value.
+//
+//	f := func() (string, error) {
+//		return "ok", nil
+//	}
+//	gt.Return1(f()).Value(t).Equal("ok") // Pass
+func (x Return1Test[T1]) Value(t testing.TB) ValueTest[T1] {
+	t.Helper()
+	return Value(t, x.NoError(t))
+}
+
+// Return1Array checks if the function returned no error in the same way as NoError, and provides ArrayTest of 1st returned slice. It is a function instead of a method because a method can not narrow T1 to a slice type.
+//
+//	f := func() ([]string, error) {
+//		return []string{"a", "b"}, nil
+//	}
+//	gt.Return1Array(t, gt.Return1(f())).Length(2).Has("a") // Pass
+func Return1Array[E any](t testing.TB, x Return1Test[[]E]) ArrayTest[E] {
+	t.Helper()
+	return Array(t, x.NoError(t))
+}
+
+// R1Array is sugar syntax of Return1Array.
+func R1Array[E any](t testing.TB, x Return1Test[[]E]) ArrayTest[E] {
+	t.Helper()
+	return Return1Array(t, x)
+}
+
 type Return2Test[T1, T2 any] struct {
 	r1  T1
 	r2  T2
@@ -81,17 +124,38 @@ func (x Return2Test[T1, T2]) Error(t testing.TB) ErrorTest {
 	return Error(t, x.err)
 }
 
+// ErrorAndZero check if the function returned error and all other returned values are zero values. It provides ErrorTest
+func (x Return2Test[T1, T2]) ErrorAndZero(t testing.TB) ErrorTest {
+	t.Helper()
+	checkZeroValues(t, x.r1, x.r2)
+	return x.Error(t)
+}
+
 // NoError check if the function returned no error. If error is not nil, it will fail. If error is nil, it provides 1st and 2nd returned value.
 func (x Return2Test[T1, T2]) NoError(t testing.TB) (T1, T2) {
 	t.Helper()
 	if x.err != nil {
-		t.Errorf("got errored, but should not get error\n%s", DumpError(x.err))
+		t.Errorf("got errored, but should not get error\n%s", dumpError(t, x.err))
 		t.FailNow()
 	}
 
 	return x.r1, x.r2
 }
 
+// Values check if the function returned no error in the same way as NoError, and provides ValueTest of each returned value except error.
+//
+//	f := func() (string, int, error) {
+//		return "ok", 1, nil
+//	}
+//	s, i := gt.Return2(f()).Values(t)
+//	s.Equal("ok") // Pass
+//	i.Equal(1)    // Pass
+func (x Return2Test[T1, T2]) Values(t testing.TB) (ValueTest[T1], ValueTest[T2]) {
+	t.Helper()
+	r1, r2 := x.NoError(t)
+	return Value(t, r1), Value(t, r2)
+}
+
 type Return3Test[T1, T2, T3 any] struct {
 	r1  T1
 	r2  T2
@@ -132,13 +196,165 @@ func (x Return3Test[T1, T2, T3]) Error(t testing.TB) ErrorTest {
 	return Error(t, x.err)
 }
 
+// ErrorAndZero check if the function returned error and all other returned values are zero values. It provides ErrorTest
+func (x Return3Test[T1, T2, T3]) ErrorAndZero(t testing.TB) ErrorTest {
+	t.Helper()
+	checkZeroValues(t, x.r1, x.r2, x.r3)
+	return x.Error(t)
+}
+
 // NoError check if the function returned no error. If error is not nil, it will fail. If error is nil, it provides 1st, 2nd and 3rd returned value.
 func (x Return3Test[T1, T2, T3]) NoError(t testing.TB) (T1, T2, T3) {
 	t.Helper()
 	if x.err != nil {
-		t.Errorf("got errored, but should not get error\n%s", DumpError(x.err))
+		t.Errorf("got errored, but should not get error\n%s", dumpError(t, x.err))
 		t.FailNow()
 	}
 
 	return x.r1, x.r2, x.r3
 }
+
+// Values check if the function returned no error, and provides ValueTest of all returned values except error.
+func (x Return3Test[T1, T2, T3]) Values(t testing.TB) (ValueTest[T1], ValueTest[T2], ValueTest[T3]) {
+	t.Helper()
+	r1, r2, r3 := x.NoError(t)
+	return Value(t, r1), Value(t, r2), Value(t, r3)
+}
+
+type Return4Test[T1, T2, T3, T4 any] struct {
+	r1  T1
+	r2  T2
+	r3  T3
+	r4  T4
+	err error
+}
+
+// Return4 creates a test for returned variables (four values and one error)
+//
+//	f := func() (string, int, bool, float64, error) {
+//		return "ok", 1, true, 0.5, nil
+//	}
+//	gt.Return4(f()).Error(t)                 // Fail
+//	s, i, b, f := gt.Return4(f()).NoError(t) // Pass
+func Return4[T1, T2, T3, T4 any](r1 T1, r2 T2, r3 T3, r4 T4, err error) Return4Test[T1, T2, T3, T4] {
+	return Return4Test[T1, T2, T3, T4]{
+		r1:  r1,
+		r2:  r2,
+		r3:  r3,
+		r4:  r4,
+		err: err,
+	}
+}
+
+func R4[T1, T2, T3, T4 any](r1 T1, r2 T2, r3 T3, r4 T4, err error) Return4Test[T1, T2, T3, T4] {
+	return Return4(r1, r2, r3, r4, err)
+}
+
+// Error check if the function returned error. If error is nil, it will fail. If error is not nil, it provides ErrorTest
+func (x Return4Test[T1, T2, T3, T4]) Error(t testing.TB) ErrorTest {
+	t.Helper()
+	if x.err == nil {
+		t.Errorf("got no error, but should get errored")
+	}
+	return Error(t, x.err)
+}
+
+// ErrorAndZero check if the function returned error and all other returned values are zero values. It provides ErrorTest
+func (x Return4Test[T1, T2, T3, T4]) ErrorAndZero(t testing.TB) ErrorTest {
+	t.Helper()
+	checkZeroValues(t, x.r1, x.r2, x.r3, x.r4)
+	return x.Error(t)
+}
+
+// NoError check if the function returned no error. If error is not nil, it will fail. If error is nil, it provides 1st, 2nd, 3rd and 4th returned value.
+func (x Return4Test[T1, T2, T3, T4]) NoError(t testing.TB) (T1, T2, T3, T4) {
+	t.Helper()
+	if x.err != nil {
+		t.Errorf("got errored, but should not get error\n%s", dumpError(t, x.err))
+		t.FailNow()
+	}
+
+	return x.r1, x.r2, x.r3, x.r4
+}
+
+// Values check if the function returned no error, and provides ValueTest of all returned values except error.
+func (x Return4Test[T1, T2, T3, T4]) Values(t testing.TB) (ValueTest[T1], ValueTest[T2], ValueTest[T3], ValueTest[T4]) {
+	t.Helper()
+	r1, r2, r3, r4 := x.NoError(t)
+	return Value(t, r1), Value(t, r2), Value(t, r3), Value(t, r4)
+}
+
+type Return5Test[T1, T2, T3, T4, T5 any] struct {
+	r1  T1
+	r2  T2
+	r3  T3
+	r4  T4
+	r5  T5
+	err error
+}
+
+// Return5 creates a test for returned variables (five values and one error)
+//
+//	f := func() (string, int, bool, float64, []byte, error) {
+//		return "ok", 1, true, 0.5, nil, nil
+//	}
+//	gt.Return5(f()).Error(t)                    // Fail
+//	s, i, b, f, d := gt.Return5(f()).NoError(t) // Pass
+func Return5[T1, T2, T3, T4, T5 any](r1 T1, r2 T2, r3 T3, r4 T4, r5 T5, err error) Return5Test[T1, T2, T3, T4, T5] {
+	return Return5Test[T1, T2, T3, T4, T5]{
+		r1:  r1,
+		r2:  r2,
+		r3:  r3,
+		r4:  r4,
+		r5:  r5,
+		err: err,
+	}
+}
+
+func R5[T1, T2, T3, T4, T5 any](r1 T1, r2 T2, r3 T3, r4 T4, r5 T5, err error) Return5Test[T1, T2, T3, T4, T5] {
+	return Return5(r1, r2, r3, r4, r5, err)
+}
+
+// Error check if the function returned error. If error is nil, it will fail. If error is not nil, it provides ErrorTest
+func (x Return5Test[T1, T2, T3, T4, T5]) Error(t testing.TB) ErrorTest {
+	t.Helper()
+	if x.err == nil {
+		t.Errorf("got no error, but should get errored")
+	}
+	return Error(t, x.err)
+}
+
+// ErrorAndZero check if the function returned error and all other returned values are zero values. It provides ErrorTest
+func (x Return5Test[T1, T2, T3, T4, T5]) ErrorAndZero(t testing.TB) ErrorTest {
+	t.Helper()
+	checkZeroValues(t, x.r1, x.r2, x.r3, x.r4, x.r5)
+	return x.Error(t)
+}
+
+// NoError check if the function returned no error. If error is not nil, it will fail. If error is nil, it provides all returned values except error.
+func (x Return5Test[T1, T2, T3, T4, T5]) NoError(t testing.TB) (T1, T2, T3, T4, T5) {
+	t.Helper()
+	if x.err != nil {
+		t.Errorf("got errored, but should not get error\n%s", dumpError(t, x.err))
+		t.FailNow()
+	}
+
+	return x.r1, x.r2, x.r3, x.r4, x.r5
+}
+
+// Values check if the function returned no error, and provides ValueTest of all returned values except error.
+func (x Return5Test[T1, T2, T3, T4, T5]) Values(t testing.TB) (ValueTest[T1], ValueTest[T2], ValueTest[T3], ValueTest[T4], ValueTest[T5]) {
+	t.Helper()
+	r1, r2, r3, r4, r5 := x.NoError(t)
+	return Value(t, r1), Value(t, r2), Value(t, r3), Value(t, r4), Value(t, r5)
+}
+
+// checkZeroValues checks if all values are zero values. Position of non-zero value is reported.
+func checkZeroValues(t testing.TB, values ...any) {
+	t.Helper()
+	for i, v := range values {
+		if rv := reflect.ValueOf(v); rv.IsValid() && !rv.IsZero() {
+			t.Errorf("returned value #%d should be zero value with error, but got %+v", i+1, v)
+		}
+	}
+}
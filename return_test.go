@@ -2,6 +2,7 @@ package gt_test
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/m-mizutani/gt"
@@ -51,3 +52,138 @@ func TestReturn(t *testing.T) {
 
 	})
 }
+
+func TestReturn4And5(t *testing.T) {
+	good4 := func() (string, int, bool, []byte, error) {
+		return "ok", 1, true, []byte("x"), nil
+	}
+	bad4 := func() (string, int, bool, []byte, error) {
+		return "", 0, false, nil, errors.New("test")
+	}
+	good5 := func() (string, int, bool, []byte, float64, error) {
+		return "ok", 1, true, []byte("x"), 0.5, nil
+	}
+	bad5 := func() (string, int, bool, []byte, float64, error) {
+		return "", 0, false, nil, 0, errors.New("test")
+	}
+
+	t.Run("NoError", func(t *testing.T) {
+		s, i, b, d := gt.R4(good4()).NoError(t)
+		gt.Value(t, s).Equal("ok")
+		gt.Value(t, i).Equal(1)
+		gt.Value(t, b).Equal(true)
+		gt.Value(t, d).Equal([]byte("x"))
+
+		_, _, _, _, f := gt.R5(good5()).NoError(t)
+		gt.Value(t, f).Equal(0.5)
+	})
+
+	t.Run("NoError fails with error", func(t *testing.T) {
+		r4 := newRecorder()
+		gt.Return4(bad4()).NoError(r4)
+		r5 := newRecorder()
+		gt.Return5(bad5()).NoError(r5)
+		if r4.errs != 1 || r4.fails != 1 || r5.errs != 1 || r5.fails != 1 {
+			t.Error("should fail and stop")
+		}
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		r := newRecorder()
+		gt.Return4(bad4()).ErrorAndZero(r).Contains("test")
+		gt.Return5(bad5()).ErrorAndZero(r).Contains("test")
+		if r.errs != 0 {
+			t.Errorf("should pass, but %v", r.msgs)
+		}
+	})
+}
+
+func TestReturnErrorAndZero(t *testing.T) {
+	t.Run("pass with zero values", func(t *testing.T) {
+		r := newRecorder()
+		gt.Return1((*int)(nil), errors.New("test")).ErrorAndZero(r)
+		gt.Return2("", 0, errors.New("test")).ErrorAndZero(r)
+		gt.Return3("", 0, []int(nil), errors.New("test")).ErrorAndZero(r)
+		if r.errs != 0 {
+			t.Errorf("should pass, but %v", r.msgs)
+		}
+	})
+
+	t.Run("fail with non-zero value", func(t *testing.T) {
+		r := newRecorder()
+		gt.Return2("", 5, errors.New("test")).ErrorAndZero(r)
+		if r.errs != 1 {
+			t.Fatalf("should fail once, but %v", r.msgs)
+		}
+		if !strings.Contains(r.msgs[0], "#2") {
+			t.Errorf("position should be reported: %s", r.msgs[0])
+		}
+	})
+}
+
+func TestReturnValue(t *testing.T) {
+	f := func() (string, error) {
+		return "ok", nil
+	}
+	gt.R1(f()).Value(t).Equal("ok").Required()
+
+	r := newRecorder()
+	gt.R1("", errors.New("test")).Value(r)
+	if r.errs != 1 || r.fails != 1 {
+		t.Error("should fail and stop with error")
+	}
+}
+
+func TestReturnArray(t *testing.T) {
+	f := func() ([]string, error) {
+		return []string{"a", "b"}, nil
+	}
+	gt.R1Array(t, gt.R1(f())).Length(2).Has("a")
+	gt.Return1Array(t, gt.Return1(f())).Equal([]string{"a", "b"})
+
+	r := newRecorder()
+	gt.R1Array(r, gt.R1([]int(nil), errors.New("test")))
+	if r.errs != 1 || r.fails != 1 {
+		t.Error("should fail and stop with error")
+	}
+
+	r = newRecorder()
+	gt.R1Array(r, gt.R1([]int{1, 2}, nil)).Has(3)
+	if r.errs != 1 {
+		t.Error("should fail by Has")
+	}
+}
+
+func TestReturnValues(t *testing.T) {
+	s, i := gt.R2("ok", 1, nil).Values(t)
+	s.Equal("ok")
+	i.Equal(1)
+
+	a, b, c := gt.R3("a", 2, true, nil).Values(t)
+	a.Equal("a")
+	b.Equal(2)
+	c.Equal(true)
+
+	v1, v2, v3, v4 := gt.R4(1, 2, 3, 4, nil).Values(t)
+	v1.Equal(1)
+	v2.Equal(2)
+	v3.Equal(3)
+	v4.Equal(4)
+
+	w1, _, _, _, w5 := gt.R5(1, "2", 3.0, []int{4}, "5", nil).Values(t)
+	w1.Equal(1)
+	w5.Equal("5")
+
+	r := newRecorder()
+	gt.R2("", 0, errors.New("test")).Values(r)
+	if r.errs != 1 || r.fails != 1 {
+		t.Error("should fail and stop with error")
+	}
+
+	r = newRecorder()
+	_, n := gt.R2("ok", 1, nil).Values(r)
+	n.Equal(2)
+	if r.errs != 1 {
+		t.Error("should fail by Equal")
+	}
+}
@@ -1,10 +1,15 @@
 package gt
 
 import (
+	"container/list"
 	"fmt"
+	"reflect"
 	"regexp"
 	"strings"
+	"sync"
 	"testing"
+	"unicode"
+	"unicode/utf8"
 )
 
 type StringTest struct {
@@ -49,11 +54,65 @@ func (x StringTest) Required() StringTest {
 	return x
 }
 
-// Equal check if actual equals with expect. Default evaluation function uses reflect.DeepEqual.
+// Equal check if actual equals with expect. Default evaluation function uses reflect.DeepEqual. If expect or actual has multiple lines, unified line diff is shown on failure. For a long single line, the first differing rune offset is shown.
 func (x StringTest) Equal(expect string) StringTest {
 	x.t.Helper()
-	if !EvalCompare(x.actual, expect) {
-		msg := "values are not matched\n" + Diff(expect, x.actual)
+	if !evalCompare(x.t, x.actual, expect) {
+		msg := "values are not matched\n" + diffString(x.t, expect, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// EqualIgnoringWhitespace checks if actual equals with expect after removing all white spaces (including new lines and tabs) from both.
+//
+//	gt.String(t, "SELECT *\n  FROM users").EqualIgnoringWhitespace("SELECT * FROM users") // Pass
+func (x StringTest) EqualIgnoringWhitespace(expect string) StringTest {
+	x.t.Helper()
+	if removeWhitespace(x.actual) != removeWhitespace(expect) {
+		msg := "values are not matched ignoring white spaces\n" + diffString(x.t, expect, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// EqualFold checks if actual equals with expect under Unicode case-folding by strings.EqualFold.
+//
+//	gt.String(t, "Hello").EqualFold("HELLO") // Pass
+func (x StringTest) EqualFold(expect string) StringTest {
+	x.t.Helper()
+	if !strings.EqualFold(x.actual, expect) {
+		msg := "values are not matched ignoring case\n" + diffString(x.t, expect, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// EqualNormalized checks if actual equals with expect after normalization of both. The normalization converts CRLF to LF, trims white spaces of each line, collapses consecutive blank lines into one, and removes leading and trailing blank lines. Diff of normalized strings is shown on failure.
+//
+//	gt.String(t, "\r\n  a  \r\n\r\n\r\nb\r\n").EqualNormalized("a\n\nb") // Pass
+func (x StringTest) EqualNormalized(expect string) StringTest {
+	x.t.Helper()
+	e, a := normalizeText(expect), normalizeText(x.actual)
+	if e != a {
+		msg := "normalized values are not matched\n" + diffString(x.t, e, a)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// LinesEqual checks if lines of actual equals with expect. actual is split by LF, and CR at end of line is removed. A trailing new line of actual does not make an empty last line.
+//
+//	gt.String(t, "a\nb\n").LinesEqual([]string{"a", "b"}) // Pass
+func (x StringTest) LinesEqual(expect []string) StringTest {
+	x.t.Helper()
+	lines := splitTextLines(x.actual)
+	if len(lines) != len(expect) || (len(lines) > 0 && !evalCompare(x.t, lines, expect)) {
+		msg := "lines are not matched\n" + lineDiff(strings.Join(expect, "\n")+"\n", strings.Join(lines, "\n")+"\n")
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
 
@@ -63,7 +122,7 @@ func (x StringTest) Equal(expect string) StringTest {
 // NotEqual check if actual does not equals with expect. Default evaluation function uses reflect.DeepEqual.
 func (x StringTest) NotEqual(expect string) StringTest {
 	x.t.Helper()
-	if EvalCompare(x.actual, expect) {
+	if evalCompare(x.t, x.actual, expect) {
 		msg := fmt.Sprintf("values should not be matched, %+v", x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -216,13 +275,254 @@ func (x StringTest) NotMatch(pattern string) StringTest {
 
 func (x StringTest) match(pattern string) bool {
 	x.t.Helper()
-	ptn, err := regexp.Compile(pattern)
+	ptn := x.compile(pattern)
+	if ptn == nil {
+		return false
+	}
+
+	return ptn.MatchString(x.actual)
+}
+
+// compile returns compiled pattern. If pattern is invalid, it triggers error with compile error and stops the test by t.FailNow(), and returns nil.
+func (x StringTest) compile(pattern string) *regexp.Regexp {
+	x.t.Helper()
+	ptn, err := compilePattern(pattern)
 	if err != nil {
-		msg := fmt.Sprintf("invalid pattern, %+v", pattern)
+		msg := fmt.Sprintf("invalid pattern, %+v: %v", pattern, err)
 		x.t.Error(formatErrorMessage(x.description, msg))
 		x.t.FailNow()
-		return false
+		return nil
 	}
 
-	return ptn.MatchString(x.actual)
+	return ptn
+}
+
+// MatchThen checks if actual matches with pattern, and calls f with testing.TB and groups of the leftmost match. groups[0] is the whole match and groups[i] is i th capture group, as same as regexp.FindStringSubmatch. If actual does not match, f is not called and test will trigger error.
+//
+//	gt.String(t, "user-42@example.com").MatchThen(`^user-(\d+)@(.+)$`, func(t testing.TB, groups []string) {
+//		gt.String(t, groups[1]).Equal("42")
+//		gt.String(t, groups[2]).Equal("example.com")
+//	})
+func (x StringTest) MatchThen(pattern string, f func(t testing.TB, groups []string)) StringTest {
+	x.t.Helper()
+	ptn := x.compile(pattern)
+	if ptn == nil {
+		return x
+	}
+
+	groups := ptn.FindStringSubmatch(x.actual)
+	if groups == nil {
+		msg := fmt.Sprintf("value should match '%+v', %+v", pattern, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+		return x
+	}
+
+	f(x.t, groups)
+	return x
+}
+
+// NamedGroups returns MapTest of named capture groups in the leftmost match of pattern. Key is group name and value is matched string. A named group that does not participate in the match has empty string. If actual does not match, test will trigger error and returns MapTest of empty map.
+//
+//	gt.String(t, "2024-01-15").
+//		NamedGroups(`^(?P<year>\d{4})-(?P<month>\d{2})-(?P<day>\d{2})$`).
+//		EqualAt("year", "2024").
+//		EqualAt("month", "01")
+func (x StringTest) NamedGroups(pattern string) MapTest[string, string] {
+	x.t.Helper()
+	groups := map[string]string{}
+	result := MapTest[string, string]{
+		TestMeta: x.TestMeta,
+		actual:   groups,
+	}
+
+	ptn := x.compile(pattern)
+	if ptn == nil {
+		return result
+	}
+
+	matched := ptn.FindStringSubmatch(x.actual)
+	if matched == nil {
+		msg := fmt.Sprintf("value should match '%+v', %+v", pattern, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+		return result
+	}
+
+	for i, name := range ptn.SubexpNames() {
+		if name != "" {
+			groups[name] = matched[i]
+		}
+	}
+	return result
+}
+
+// FindAll returns ArrayTest of all successive matches of pattern in actual. If there is no match, it returns ArrayTest of empty array without error.
+//
+//	gt.String(t, "a1 b22 c333").FindAll(`\d+`).Equal([]string{"1", "22", "333"})
+func (x StringTest) FindAll(pattern string) ArrayTest[string] {
+	x.t.Helper()
+	result := ArrayTest[string]{
+		TestMeta: x.TestMeta,
+		actual:   []string{},
+	}
+
+	ptn := x.compile(pattern)
+	if ptn == nil {
+		return result
+	}
+
+	if found := ptn.FindAllString(x.actual, -1); found != nil {
+		result.actual = found
+	}
+	return result
+}
+
+// maxCachedPatterns is the number of compiled patterns kept in patternCache.
+const maxCachedPatterns = 256
+
+// patternCache has successfully compiled regular expressions by pattern to avoid compiling the same pattern repeatedly. The least recently used pattern is evicted when the cache is full, so that dynamically built patterns do not grow memory.
+var patternCache = struct {
+	mutex sync.Mutex
+	order *list.List // of *cachedPattern, most recently used first
+	items map[string]*list.Element
+}{
+	order: list.New(),
+	items: map[string]*list.Element{},
+}
+
+type cachedPattern struct {
+	pattern string
+	ptn     *regexp.Regexp
+}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	patternCache.mutex.Lock()
+	if elem, ok := patternCache.items[pattern]; ok {
+		patternCache.order.MoveToFront(elem)
+		patternCache.mutex.Unlock()
+		return elem.Value.(*cachedPattern).ptn, nil
+	}
+	patternCache.mutex.Unlock()
+
+	ptn, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	patternCache.mutex.Lock()
+	defer patternCache.mutex.Unlock()
+	if elem, ok := patternCache.items[pattern]; ok {
+		patternCache.order.MoveToFront(elem)
+		return elem.Value.(*cachedPattern).ptn, nil
+	}
+	patternCache.items[pattern] = patternCache.order.PushFront(&cachedPattern{pattern: pattern, ptn: ptn})
+	if patternCache.order.Len() > maxCachedPatterns {
+		oldest := patternCache.order.Back()
+		patternCache.order.Remove(oldest)
+		delete(patternCache.items, oldest.Value.(*cachedPattern).pattern)
+	}
+	return ptn, nil
+}
+
+// longStringThreshold is rune length of single line string to show first differing offset instead of default diff.
+const longStringThreshold = 80
+
+// diffString returns diff of strings. If Diff is configured by Configure or package level Diff is replaced, it is used. Otherwise, multi-line strings are shown as unified line diff, and long single line strings are shown with first differing rune offset.
+func diffString(t testing.TB, expect, actual string) string {
+	if cfg := lookupConfig(t); cfg != nil && cfg.Diff != nil {
+		return cfg.Diff(expect, actual)
+	}
+	if isDiffReplaced() {
+		return Diff(expect, actual)
+	}
+
+	if strings.Contains(expect, "\n") || strings.Contains(actual, "\n") {
+		return lineDiff(expect, actual)
+	}
+	if utf8.RuneCountInString(expect) > longStringThreshold || utf8.RuneCountInString(actual) > longStringThreshold {
+		return runeDiff(expect, actual)
+	}
+	return Diff(expect, actual)
+}
+
+// isDiffReplaced returns true if package level Diff is replaced from defaultDiff.
+func isDiffReplaced() bool {
+	return reflect.ValueOf(Diff).Pointer() != reflect.ValueOf(defaultDiff).Pointer()
+}
+
+// runeDiff shows the first differing rune offset and excerpts of expect and actual around it. The differing rune is enclosed by brackets.
+func runeDiff(expect, actual string) string {
+	e, a := []rune(expect), []rune(actual)
+	offset := 0
+	for offset < len(e) && offset < len(a) && e[offset] == a[offset] {
+		offset++
+	}
+
+	const window = 20
+	excerpt := func(r []rune) string {
+		start := offset - window
+		if start < 0 {
+			start = 0
+		}
+		end := offset + 1 + window
+		if end > len(r) {
+			end = len(r)
+		}
+
+		var b strings.Builder
+		if start > 0 {
+			b.WriteString("...")
+		}
+		b.WriteString(string(r[start:offset]))
+		if offset < len(r) {
+			b.WriteString("[" + string(r[offset]) + "]")
+			b.WriteString(string(r[offset+1 : end]))
+		} else {
+			b.WriteString("[<EOS>]")
+		}
+		if end < len(r) {
+			b.WriteString("...")
+		}
+		return b.String()
+	}
+
+	return fmt.Sprintf("first difference at rune offset %d (expect length %d, actual length %d)\nexpect: %s\nactual: %s", offset, len(e), len(a), excerpt(e), excerpt(a))
+}
+
+func removeWhitespace(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+// splitTextLines splits s by LF and removes CR at end of each line. Trailing new line does not make an empty last line.
+func splitTextLines(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	lines := strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+	for i := range lines {
+		lines[i] = strings.TrimSuffix(lines[i], "\r")
+	}
+	return lines
+}
+
+func normalizeText(s string) string {
+	var lines []string
+	blank := false
+	for _, line := range splitTextLines(s) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			blank = len(lines) > 0
+			continue
+		}
+		if blank {
+			lines = append(lines, "")
+			blank = false
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
 }
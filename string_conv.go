@@ -0,0 +1,92 @@
+package gt
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+func (x StringTest) reportConversion(typ string, err error) {
+	x.t.Helper()
+	msg := fmt.Sprintf("value can not be converted to %s, %v", typ, err)
+	x.t.Error(formatErrorMessage(x.description, msg))
+}
+
+// AsInt converts actual to int by strconv.Atoi and provides NumberTest of the converted value. If conversion fails, test will trigger error with the parse error and NumberTest has zero value. testing.TB and description are kept.
+//
+//	gt.String(t, resp.Header.Get("Content-Length")).AsInt().Greater(0)
+func (x StringTest) AsInt() NumberTest[int] {
+	x.t.Helper()
+	v, err := strconv.Atoi(x.actual)
+	if err != nil {
+		x.reportConversion("int", err)
+		v = 0
+	}
+	return NumberTest[int]{
+		TestMeta: x.TestMeta,
+		actual:   v,
+	}
+}
+
+// AsFloat converts actual to float64 by strconv.ParseFloat and provides NumberTest of the converted value. If conversion fails, test will trigger error with the parse error and NumberTest has zero value. testing.TB and description are kept.
+//
+//	gt.String(t, "0.25").AsFloat().InDelta(0.25, 1e-9)
+func (x StringTest) AsFloat() NumberTest[float64] {
+	x.t.Helper()
+	v, err := strconv.ParseFloat(x.actual, 64)
+	if err != nil {
+		x.reportConversion("float64", err)
+		v = 0
+	}
+	return NumberTest[float64]{
+		TestMeta: x.TestMeta,
+		actual:   v,
+	}
+}
+
+// AsBool converts actual to bool by strconv.ParseBool and provides BoolTest of the converted value. If conversion fails, test will trigger error with the parse error and BoolTest has false. testing.TB and description are kept.
+//
+//	gt.String(t, os.Getenv("FEATURE_ENABLED")).AsBool().True()
+func (x StringTest) AsBool() BoolTest {
+	x.t.Helper()
+	v, err := strconv.ParseBool(x.actual)
+	if err != nil {
+		x.reportConversion("bool", err)
+	}
+	return BoolTest{
+		TestMeta: x.TestMeta,
+		actual:   v,
+	}
+}
+
+// AsTime parses actual by time.Parse with layout and provides TimeTest of the parsed time. If parsing fails, test will trigger error with the parse error and TimeTest has zero time. testing.TB and description are kept.
+//
+//	gt.String(t, "2024-01-15T10:30:00Z").AsTime(time.RFC3339).After(since)
+func (x StringTest) AsTime(layout string) TimeTest {
+	x.t.Helper()
+	v, err := time.Parse(layout, x.actual)
+	if err != nil {
+		x.reportConversion("time", err)
+		v = time.Time{}
+	}
+	return TimeTest{
+		TestMeta: x.TestMeta,
+		actual:   v,
+	}
+}
+
+// AsJSON decodes actual as JSON and provides JSONTest. If actual is not valid JSON, test will trigger error with the decode error, and methods of the JSONTest do nothing. testing.TB and description are kept.
+//
+//	gt.String(t, stdout).AsJSON().Has("/items/0/id")
+func (x StringTest) AsJSON() JSONTest {
+	x.t.Helper()
+	v, err := decodeJSON([]byte(x.actual))
+	if err != nil {
+		x.reportConversion("JSON", err)
+	}
+	return JSONTest{
+		TestMeta: x.TestMeta,
+		actual:   v,
+		valid:    err == nil,
+	}
+}
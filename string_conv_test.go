@@ -0,0 +1,97 @@
+package gt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestStringConversion(t *testing.T) {
+	testCases := map[string]struct {
+		test func(t testing.TB)
+		pass bool
+	}{
+		"AsInt pass": {
+			test: func(t testing.TB) { gt.S(t, "42").AsInt().Equal(42) },
+			pass: true,
+		},
+		"AsInt fail by parse": {
+			test: func(t testing.TB) { gt.S(t, "4x2").AsInt() },
+			pass: false,
+		},
+		"AsInt fail by range": {
+			test: func(t testing.TB) { gt.S(t, "99999999999999999999").AsInt() },
+			pass: false,
+		},
+		"AsInt fail by assertion": {
+			test: func(t testing.TB) { gt.S(t, "42").AsInt().Less(10) },
+			pass: false,
+		},
+		"AsFloat pass": {
+			test: func(t testing.TB) { gt.S(t, "0.25").AsFloat().InDelta(0.25, 1e-9) },
+			pass: true,
+		},
+		"AsFloat fail": {
+			test: func(t testing.TB) { gt.S(t, "quarter").AsFloat() },
+			pass: false,
+		},
+		"AsBool pass": {
+			test: func(t testing.TB) { gt.S(t, "true").AsBool().True() },
+			pass: true,
+		},
+		"AsBool fail": {
+			test: func(t testing.TB) { gt.S(t, "yes").AsBool() },
+			pass: false,
+		},
+		"AsTime pass": {
+			test: func(t testing.TB) {
+				gt.S(t, "2024-01-15T10:30:00Z").AsTime(time.RFC3339).
+					Equal(time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC))
+			},
+			pass: true,
+		},
+		"AsTime fail": {
+			test: func(t testing.TB) { gt.S(t, "2024/01/15").AsTime(time.RFC3339) },
+			pass: false,
+		},
+		"AsJSON pass": {
+			test: func(t testing.TB) { gt.S(t, `{"items":[{"id":1}]}`).AsJSON().Has("/items/0/id") },
+			pass: true,
+		},
+		"AsJSON fail by trailing data": {
+			test: func(t testing.TB) { gt.S(t, `{"id":1}]`).AsJSON() },
+			pass: false,
+		},
+		"AsJSON fail": {
+			test: func(t testing.TB) { gt.S(t, `{"items":`).AsJSON() },
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(r)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestStringConversionMessage(t *testing.T) {
+	r := newRecorder()
+	gt.S(r, "4x2").Describe("Content-Length").AsInt().Equal(0)
+	gt.Number(t, r.errs).Equal(1)
+	gt.S(t, r.msgs[0]).Equal("Content-Length\nvalue can not be converted to int, strconv.Atoi: parsing \"4x2\": invalid syntax")
+
+	r = newRecorder()
+	gt.S(r, "10").Describe("retry count").AsInt().Greater(20)
+	gt.Number(t, r.errs).Equal(1)
+	gt.S(t, r.msgs[0]).HasPrefix("retry count\n")
+
+	r = newRecorder()
+	gt.S(r, `{`).AsJSON().Equal(`{}`).Has("/a")
+	gt.Number(t, r.errs).Equal(1)
+}
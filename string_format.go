@@ -0,0 +1,267 @@
+package gt
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (x StringTest) reportFormat(format string, err error) {
+	x.t.Helper()
+	msg := fmt.Sprintf("value should be %s, %q: %v", format, x.actual, err)
+	x.t.Error(formatErrorMessage(x.description, msg))
+}
+
+// IsUUID checks if actual is UUID in canonical form (8-4-4-4-12 hex digits) with RFC 4122 variant. If version is not 0, version of the UUID must be the same.
+//
+//	gt.String(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479").IsUUID(4) // Pass
+//	gt.String(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479").IsUUID(1) // Fail
+func (x StringTest) IsUUID(version int) StringTest {
+	x.t.Helper()
+	if err := parseUUID(x.actual, version); err != nil {
+		x.reportFormat("UUID", err)
+	}
+	return x
+}
+
+func parseUUID(s string, version int) error {
+	if len(s) != 36 {
+		return fmt.Errorf("length is %d, want 36", len(s))
+	}
+	for i := 0; i < len(s); i++ {
+		switch i {
+		case 8, 13, 18, 23:
+			if s[i] != '-' {
+				return fmt.Errorf("'-' is expected at offset %d, but got %q", i, s[i])
+			}
+		default:
+			if !isHexDigit(s[i]) {
+				return fmt.Errorf("invalid hex character %q at offset %d", s[i], i)
+			}
+		}
+	}
+
+	if strings.IndexByte("89abAB", s[19]) < 0 {
+		return fmt.Errorf("variant is not RFC 4122, %q at offset 19", s[19])
+	}
+
+	actual, _ := strconv.ParseInt(s[14:15], 16, 8)
+	if version != 0 && int(actual) != version {
+		return fmt.Errorf("version is %d, want %d", actual, version)
+	}
+	return nil
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+// IsURL checks if actual is absolute URL that has scheme and host, parsed by url.Parse. If schemes are given, scheme of the URL must be one of them.
+//
+//	gt.String(t, "https://example.com/path").IsURL("https") // Pass
+//	gt.String(t, "http://example.com/path").IsURL("https")  // Fail
+//	gt.String(t, "/path").IsURL()                           // Fail
+func (x StringTest) IsURL(schemes ...string) StringTest {
+	x.t.Helper()
+
+	u, err := url.Parse(x.actual)
+	switch {
+	case err != nil:
+		x.reportFormat("URL", err)
+	case u.Scheme == "":
+		x.reportFormat("URL", fmt.Errorf("scheme is missing"))
+	case u.Host == "":
+		x.reportFormat("URL", fmt.Errorf("host is missing"))
+	case len(schemes) > 0 && !containsFold(schemes, u.Scheme):
+		x.reportFormat("URL", fmt.Errorf("scheme is %q, want one of %q", u.Scheme, schemes))
+	}
+	return x
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsIP checks if actual is IPv4 or IPv6 address, parsed by netip.ParseAddr.
+//
+//	gt.String(t, "192.0.2.1").IsIP()   // Pass
+//	gt.String(t, "192.0.2.256").IsIP() // Fail
+func (x StringTest) IsIP() StringTest {
+	x.t.Helper()
+	if _, err := netip.ParseAddr(x.actual); err != nil {
+		x.reportFormat("IP address", err)
+	}
+	return x
+}
+
+// IsCIDR checks if actual is IP address prefix in CIDR notation, parsed by netip.ParsePrefix.
+//
+//	gt.String(t, "192.0.2.0/24").IsCIDR() // Pass
+//	gt.String(t, "192.0.2.0/33").IsCIDR() // Fail
+func (x StringTest) IsCIDR() StringTest {
+	x.t.Helper()
+	if _, err := netip.ParsePrefix(x.actual); err != nil {
+		x.reportFormat("CIDR", err)
+	}
+	return x
+}
+
+// IsEmail checks if actual is email address in RFC 5322, parsed by mail.ParseAddress. Display name and angle brackets (e.g. "Alice <alice@example.com>") are not allowed.
+//
+//	gt.String(t, "alice@example.com").IsEmail() // Pass
+//	gt.String(t, "alice@").IsEmail()            // Fail
+func (x StringTest) IsEmail() StringTest {
+	x.t.Helper()
+
+	addr, err := mail.ParseAddress(x.actual)
+	switch {
+	case err != nil:
+		x.reportFormat("email address", err)
+	case addr.Name != "" || addr.Address != x.actual:
+		x.reportFormat("email address", fmt.Errorf("only address part %q is expected", addr.Address))
+	}
+	return x
+}
+
+// IsSemver checks if actual is semantic version (https://semver.org), MAJOR.MINOR.PATCH with optional pre-release and build metadata. A leading "v" is allowed.
+//
+//	gt.String(t, "v1.2.3-rc.1+build.5").IsSemver() // Pass
+//	gt.String(t, "1.02.3").IsSemver()              // Fail
+func (x StringTest) IsSemver() StringTest {
+	x.t.Helper()
+	if err := parseSemver(x.actual); err != nil {
+		x.reportFormat("semantic version", err)
+	}
+	return x
+}
+
+func parseSemver(s string) error {
+	v := strings.TrimPrefix(s, "v")
+
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		if err := parseSemverIdentifiers("build metadata", v[i+1:], false); err != nil {
+			return err
+		}
+		v = v[:i]
+	}
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		if err := parseSemverIdentifiers("pre-release", v[i+1:], true); err != nil {
+			return err
+		}
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("version core %q must be MAJOR.MINOR.PATCH", v)
+	}
+	for i, name := range []string{"major", "minor", "patch"} {
+		if err := parseSemverNumber(parts[i]); err != nil {
+			return fmt.Errorf("%s version %q %v", name, parts[i], err)
+		}
+	}
+	return nil
+}
+
+func parseSemverNumber(s string) error {
+	if s == "" {
+		return fmt.Errorf("is empty")
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return fmt.Errorf("has non-digit character %q", s[i])
+		}
+	}
+	if len(s) > 1 && s[0] == '0' {
+		return fmt.Errorf("has leading zero")
+	}
+	return nil
+}
+
+func parseSemverIdentifiers(part, s string, numeric bool) error {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return fmt.Errorf("%s %q has empty identifier", part, s)
+		}
+		allDigits := true
+		for i := 0; i < len(id); i++ {
+			c := id[i]
+			switch {
+			case '0' <= c && c <= '9':
+			case ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || c == '-':
+				allDigits = false
+			default:
+				return fmt.Errorf("%s identifier %q has invalid character %q", part, id, c)
+			}
+		}
+		if numeric && allDigits && len(id) > 1 && id[0] == '0' {
+			return fmt.Errorf("%s identifier %q has leading zero", part, id)
+		}
+	}
+	return nil
+}
+
+// IsBase64 checks if actual is standard base64 encoded string with padding, decoded by base64.StdEncoding.
+//
+//	gt.String(t, "aGVsbG8=").IsBase64() // Pass
+//	gt.String(t, "aGVsbG8").IsBase64()  // Fail
+func (x StringTest) IsBase64() StringTest {
+	x.t.Helper()
+	if _, err := base64.StdEncoding.DecodeString(x.actual); err != nil {
+		x.reportFormat("base64", err)
+	}
+	return x
+}
+
+// IsHex checks if actual is hex encoded bytes, decoded by hex.DecodeString. Then length of actual must be even.
+//
+//	gt.String(t, "deadBEEF").IsHex() // Pass
+//	gt.String(t, "xyz").IsHex()      // Fail
+func (x StringTest) IsHex() StringTest {
+	x.t.Helper()
+	if _, err := hex.DecodeString(x.actual); err != nil {
+		x.reportFormat("hex", err)
+	}
+	return x
+}
+
+// IsJSON checks if actual is valid JSON. Offset of syntax error is reported on failure.
+//
+//	gt.String(t, `{"name":"Alice"}`).IsJSON() // Pass
+//	gt.String(t, `{"name":}`).IsJSON()        // Fail
+func (x StringTest) IsJSON() StringTest {
+	x.t.Helper()
+
+	var v any
+	if err := json.Unmarshal([]byte(x.actual), &v); err != nil {
+		if serr, ok := err.(*json.SyntaxError); ok {
+			err = fmt.Errorf("%w (offset %d)", serr, serr.Offset)
+		}
+		x.reportFormat("JSON", err)
+	}
+	return x
+}
+
+// IsRFC3339 checks if actual is time in RFC 3339 format, parsed by time.Parse with time.RFC3339. Fractional seconds are allowed.
+//
+//	gt.String(t, "2024-01-15T10:30:00Z").IsRFC3339() // Pass
+//	gt.String(t, "2024-01-15 10:30:00").IsRFC3339()  // Fail
+func (x StringTest) IsRFC3339() StringTest {
+	x.t.Helper()
+	if _, err := time.Parse(time.RFC3339, x.actual); err != nil {
+		x.reportFormat("RFC 3339 time", err)
+	}
+	return x
+}
@@ -0,0 +1,85 @@
+package gt_test
+
+import (
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestStringFormat(t *testing.T) {
+	testCases := map[string]struct {
+		test  func(s gt.StringTest)
+		value string
+		pass  bool
+	}{
+		"IsUUID pass":               {value: "f47ac10b-58cc-4372-a567-0e02b2c3d479", test: func(s gt.StringTest) { s.IsUUID(4) }, pass: true},
+		"IsUUID pass with any":      {value: "F47AC10B-58CC-1372-A567-0E02B2C3D479", test: func(s gt.StringTest) { s.IsUUID(0) }, pass: true},
+		"IsUUID fail by version":    {value: "f47ac10b-58cc-4372-a567-0e02b2c3d479", test: func(s gt.StringTest) { s.IsUUID(7) }, pass: false},
+		"IsUUID fail by character":  {value: "g47ac10b-58cc-4372-a567-0e02b2c3d479", test: func(s gt.StringTest) { s.IsUUID(0) }, pass: false},
+		"IsUUID fail by hyphen":     {value: "f47ac10b058cc-4372-a567-0e02b2c3d479", test: func(s gt.StringTest) { s.IsUUID(0) }, pass: false},
+		"IsUUID fail by variant":    {value: "f47ac10b-58cc-4372-c567-0e02b2c3d479", test: func(s gt.StringTest) { s.IsUUID(0) }, pass: false},
+		"IsUUID fail by length":     {value: "f47ac10b-58cc-4372-a567", test: func(s gt.StringTest) { s.IsUUID(0) }, pass: false},
+		"IsURL pass":                {value: "https://example.com/path?q=1", test: func(s gt.StringTest) { s.IsURL() }, pass: true},
+		"IsURL pass with scheme":    {value: "HTTPS://example.com", test: func(s gt.StringTest) { s.IsURL("http", "https") }, pass: true},
+		"IsURL fail by scheme":      {value: "ftp://example.com", test: func(s gt.StringTest) { s.IsURL("http", "https") }, pass: false},
+		"IsURL fail by relative":    {value: "/path/to", test: func(s gt.StringTest) { s.IsURL() }, pass: false},
+		"IsURL fail by no host":     {value: "mailto:alice@example.com", test: func(s gt.StringTest) { s.IsURL() }, pass: false},
+		"IsURL fail by parse error": {value: "http://[::1", test: func(s gt.StringTest) { s.IsURL() }, pass: false},
+		"IsIP pass with v4":         {value: "192.0.2.1", test: func(s gt.StringTest) { s.IsIP() }, pass: true},
+		"IsIP pass with v6":         {value: "2001:db8::1", test: func(s gt.StringTest) { s.IsIP() }, pass: true},
+		"IsIP fail":                 {value: "192.0.2.256", test: func(s gt.StringTest) { s.IsIP() }, pass: false},
+		"IsCIDR pass":               {value: "192.0.2.0/24", test: func(s gt.StringTest) { s.IsCIDR() }, pass: true},
+		"IsCIDR fail":               {value: "192.0.2.0/33", test: func(s gt.StringTest) { s.IsCIDR() }, pass: false},
+		"IsEmail pass":              {value: "alice@example.com", test: func(s gt.StringTest) { s.IsEmail() }, pass: true},
+		"IsEmail fail":              {value: "alice@", test: func(s gt.StringTest) { s.IsEmail() }, pass: false},
+		"IsEmail fail with name":    {value: "Alice <alice@example.com>", test: func(s gt.StringTest) { s.IsEmail() }, pass: false},
+		"IsSemver pass":             {value: "1.2.3", test: func(s gt.StringTest) { s.IsSemver() }, pass: true},
+		"IsSemver pass with all":    {value: "v1.0.0-rc.1+build.007", test: func(s gt.StringTest) { s.IsSemver() }, pass: true},
+		"IsSemver fail by zero":     {value: "1.02.3", test: func(s gt.StringTest) { s.IsSemver() }, pass: false},
+		"IsSemver fail by parts":    {value: "1.2", test: func(s gt.StringTest) { s.IsSemver() }, pass: false},
+		"IsSemver fail by pre":      {value: "1.2.3-rc..1", test: func(s gt.StringTest) { s.IsSemver() }, pass: false},
+		"IsSemver fail by pre zero": {value: "1.2.3-01", test: func(s gt.StringTest) { s.IsSemver() }, pass: false},
+		"IsBase64 pass":             {value: "aGVsbG8=", test: func(s gt.StringTest) { s.IsBase64() }, pass: true},
+		"IsBase64 fail":             {value: "aGVsbG8", test: func(s gt.StringTest) { s.IsBase64() }, pass: false},
+		"IsHex pass":                {value: "deadBEEF", test: func(s gt.StringTest) { s.IsHex() }, pass: true},
+		"IsHex fail":                {value: "xyz0", test: func(s gt.StringTest) { s.IsHex() }, pass: false},
+		"IsHex fail by odd length":  {value: "abc", test: func(s gt.StringTest) { s.IsHex() }, pass: false},
+		"IsJSON pass":               {value: `{"name":"Alice","tags":[1,2]}`, test: func(s gt.StringTest) { s.IsJSON() }, pass: true},
+		"IsJSON fail":               {value: `{"name":}`, test: func(s gt.StringTest) { s.IsJSON() }, pass: false},
+		"IsRFC3339 pass":            {value: "2024-01-15T10:30:00.123+09:00", test: func(s gt.StringTest) { s.IsRFC3339() }, pass: true},
+		"IsRFC3339 fail":            {value: "2024-01-15 10:30:00", test: func(s gt.StringTest) { s.IsRFC3339() }, pass: false},
+		"IsRFC3339 fail by month":   {value: "2024-13-15T10:30:00Z", test: func(s gt.StringTest) { s.IsRFC3339() }, pass: false},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.S(r, tc.value))
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestStringFormatMessage(t *testing.T) {
+	testCases := map[string]struct {
+		test   func(s gt.StringTest)
+		value  string
+		expect string
+	}{
+		"UUID":   {value: "f47ac10b-58cc-4372-a567-0e02b2c3d47z", test: func(s gt.StringTest) { s.IsUUID(4) }, expect: "invalid hex character 'z' at offset 35"},
+		"URL":    {value: "ftp://example.com", test: func(s gt.StringTest) { s.IsURL("https") }, expect: `scheme is "ftp", want one of ["https"]`},
+		"Semver": {value: "1.02.3", test: func(s gt.StringTest) { s.IsSemver() }, expect: `minor version "02" has leading zero`},
+		"JSON":   {value: `{"name":}`, test: func(s gt.StringTest) { s.IsJSON() }, expect: "(offset 9)"},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.S(r, tc.value))
+			gt.Number(t, r.errs).Equal(1)
+			gt.S(t, r.msgs[0]).Contains(tc.expect)
+		})
+	}
+}
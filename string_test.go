@@ -1,6 +1,10 @@
 package gt_test
 
 import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/m-mizutani/gt"
@@ -64,3 +68,214 @@ func TestString(t *testing.T) {
 		gt.S(t, "hello, world").ContainsNone("goodbye", "farewell")
 	})
 }
+
+func TestStringComparison(t *testing.T) {
+	testCases := map[string]struct {
+		test func(t testing.TB)
+		pass bool
+	}{
+		"EqualIgnoringWhitespace pass": {
+			test: func(t testing.TB) {
+				gt.S(t, "SELECT *\n  FROM users\tWHERE id = 1").EqualIgnoringWhitespace("SELECT * FROM users WHERE id=1")
+			},
+			pass: true,
+		},
+		"EqualIgnoringWhitespace fail": {
+			test: func(t testing.TB) {
+				gt.S(t, "SELECT * FROM users").EqualIgnoringWhitespace("SELECT * FROM groups")
+			},
+			pass: false,
+		},
+		"EqualFold pass": {
+			test: func(t testing.TB) { gt.S(t, "Hello, Gopher").EqualFold("HELLO, gopher") },
+			pass: true,
+		},
+		"EqualFold fail": {
+			test: func(t testing.TB) { gt.S(t, "Hello").EqualFold("Hallo") },
+			pass: false,
+		},
+		"EqualNormalized pass": {
+			test: func(t testing.TB) {
+				gt.S(t, "\r\n  a  \r\n\r\n\r\nb\r\n\n").EqualNormalized("a\n\nb")
+			},
+			pass: true,
+		},
+		"EqualNormalized fail": {
+			test: func(t testing.TB) { gt.S(t, "a\nb").EqualNormalized("a\n\nb") },
+			pass: false,
+		},
+		"LinesEqual pass": {
+			test: func(t testing.TB) { gt.S(t, "a\r\nb\n").LinesEqual([]string{"a", "b"}) },
+			pass: true,
+		},
+		"LinesEqual pass with empty": {
+			test: func(t testing.TB) { gt.S(t, "").LinesEqual(nil) },
+			pass: true,
+		},
+		"LinesEqual fail": {
+			test: func(t testing.TB) { gt.S(t, "a\nb\nc").LinesEqual([]string{"a", "c"}) },
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(r)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestStringEqualDiff(t *testing.T) {
+	t.Run("multi-line string shows line diff", func(t *testing.T) {
+		r := newRecorder()
+		gt.S(r, "line1\nline2\nline3\n").Equal("line1\nline-2\nline3\n")
+		gt.Number(t, r.errs).Equal(1)
+		gt.S(t, r.msgs[0]).
+			Contains("--- expect\n+++ actual\n").
+			Contains("-line-2\n+line2\n")
+	})
+
+	t.Run("long single line shows first differing rune", func(t *testing.T) {
+		expect := strings.Repeat("あ", 100) + "xyz"
+		actual := strings.Repeat("あ", 100) + "xYz"
+		r := newRecorder()
+		gt.S(r, actual).Equal(expect)
+		gt.Number(t, r.errs).Equal(1)
+		gt.S(t, r.msgs[0]).
+			Contains("first difference at rune offset 101").
+			Contains("expect: ..." + strings.Repeat("あ", 19) + "x[y]z\n").
+			Contains("actual: ..." + strings.Repeat("あ", 19) + "x[Y]z")
+	})
+
+	t.Run("long single line shows end of string", func(t *testing.T) {
+		expect := strings.Repeat("a", 100)
+		r := newRecorder()
+		gt.S(r, expect+"b").Equal(expect)
+		gt.S(t, r.msgs[0]).Contains("expect: ..." + strings.Repeat("a", 20) + "[<EOS>]")
+	})
+
+	t.Run("replaced Diff is used", func(t *testing.T) {
+		orig := gt.Diff
+		gt.Diff = func(expect, actual any) string { return "custom diff" }
+		defer func() { gt.Diff = orig }()
+
+		r := newRecorder()
+		gt.S(r, "line1\nline2").Equal("line1\nline-2")
+		gt.S(r, strings.Repeat("a", 100)).Equal(strings.Repeat("b", 100))
+		gt.Number(t, r.errs).Equal(2)
+		gt.S(t, r.msgs[0]).Contains("custom diff").NotContains("--- expect")
+		gt.S(t, r.msgs[1]).Contains("custom diff").NotContains("first difference")
+	})
+}
+
+func TestStringCaptureGroups(t *testing.T) {
+	testCases := map[string]struct {
+		test func(t testing.TB)
+		pass bool
+	}{
+		"MatchThen pass": {
+			test: func(t testing.TB) {
+				gt.S(t, "user-42@example.com").MatchThen(`^user-(\d+)@(.+)$`, func(t testing.TB, groups []string) {
+					gt.A(t, groups).Equal([]string{"user-42@example.com", "42", "example.com"})
+				})
+			},
+			pass: true,
+		},
+		"MatchThen fail in callback": {
+			test: func(t testing.TB) {
+				gt.S(t, "user-42").MatchThen(`^user-(\d+)$`, func(t testing.TB, groups []string) {
+					gt.S(t, groups[1]).Equal("43")
+				})
+			},
+			pass: false,
+		},
+		"MatchThen fail without match": {
+			test: func(t testing.TB) {
+				gt.S(t, "admin").MatchThen(`^user-(\d+)$`, func(t testing.TB, groups []string) {
+					t.Error("should not be called")
+				})
+			},
+			pass: false,
+		},
+		"NamedGroups pass": {
+			test: func(t testing.TB) {
+				gt.S(t, "2024-01-15").
+					NamedGroups(`^(?P<year>\d{4})-(?P<month>\d{2})-(\d{2})(?P<time>T.+)?$`).
+					Equal(map[string]string{"year": "2024", "month": "01", "time": ""})
+			},
+			pass: true,
+		},
+		"NamedGroups fail without match": {
+			test: func(t testing.TB) {
+				gt.S(t, "2024/01/15").NamedGroups(`^(?P<year>\d{4})-`)
+			},
+			pass: false,
+		},
+		"FindAll pass": {
+			test: func(t testing.TB) {
+				gt.S(t, "a1 b22 c333").FindAll(`\d+`).Equal([]string{"1", "22", "333"})
+			},
+			pass: true,
+		},
+		"FindAll pass without match": {
+			test: func(t testing.TB) {
+				gt.S(t, "abc").FindAll(`\d+`).Length(0)
+			},
+			pass: true,
+		},
+		"FindAll fail": {
+			test: func(t testing.TB) {
+				gt.S(t, "a1 b22").FindAll(`\d+`).Has("333")
+			},
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(r)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestStringInvalidPattern(t *testing.T) {
+	for name, test := range map[string]func(t testing.TB){
+		"Match":       func(t testing.TB) { gt.S(t, "abc").Match(`a(b`) },
+		"MatchThen":   func(t testing.TB) { gt.S(t, "abc").MatchThen(`a(b`, func(t testing.TB, groups []string) {}) },
+		"NamedGroups": func(t testing.TB) { gt.S(t, "abc").NamedGroups(`a(b`) },
+		"FindAll":     func(t testing.TB) { gt.S(t, "abc").FindAll(`a(b`) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := newRecorder()
+			test(r)
+			gt.Number(t, r.errs).Equal(1)
+			gt.Number(t, r.fails).Equal(1)
+			gt.S(t, r.msgs[0]).Contains("invalid pattern, a(b: error parsing regexp: missing closing )")
+		})
+	}
+}
+
+func TestStringMatchManyPatterns(t *testing.T) {
+	// More patterns than the cache size. Evicted patterns must be compiled again correctly.
+	for round := 0; round < 2; round++ {
+		for i := 0; i < 300; i++ {
+			s := fmt.Sprintf("item-%d", i)
+			gt.S(t, s).Match("^" + regexp.QuoteMeta(s) + "$")
+			gt.Error(t, errors.New(s)).Match(`^item-\d+$`)
+
+			r := newRecorder()
+			gt.S(r, s+"x").Match("^" + regexp.QuoteMeta(s) + "$")
+			if r.errs != 1 {
+				t.Fatalf("should not match %q", s+"x")
+			}
+		}
+	}
+}
@@ -0,0 +1,335 @@
+package gt
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type StructTest[T any] struct {
+	TestMeta
+	actual T
+}
+
+// Struct provides StructTest that navigates fields of nested struct by path. A path consists of field names separated by dot, slice/array index and map key in brackets. Pointers and interfaces are dereferenced automatically.
+//
+//	gt.Struct(t, pod).
+//		FieldEqual("Spec.Containers[0].Image", "nginx:latest").
+//		FieldEqual("Metadata.Labels[app]", "web")
+func Struct[T any](t testing.TB, actual T) StructTest[T] {
+	t.Helper()
+	return StructTest[T]{
+		TestMeta: TestMeta{t: t},
+		actual:   actual,
+	}
+}
+
+// Describe sets a description for the test. The description will be displayed when the test fails.
+func (x StructTest[T]) Describe(description string) StructTest[T] {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when the test fails.
+func (x StructTest[T]) Describef(format string, args ...any) StructTest[T] {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test. If errors has been occurred in previous test, it immediately stop test by t.FailNow().
+func (x StructTest[T]) Required() StructTest[T] {
+	x.requiredWithMeta()
+	return x
+}
+
+// Field calls f with testing.TB and a value specified by path. If the path can not be resolved, f is not called and test will trigger error with the path segment that failed.
+//
+//	gt.Struct(t, pod).Field("Spec.Containers[0]", func(t testing.TB, v any) {
+//		c := gt.Cast[Container](t, v)
+//		gt.String(t, c.Image).HasPrefix("nginx")
+//	})
+func (x StructTest[T]) Field(path string, f func(t testing.TB, v any)) StructTest[T] {
+	x.t.Helper()
+
+	v, err := lookupField(reflect.ValueOf(x.actual), path)
+	if err != nil {
+		x.t.Error(formatErrorMessage(x.description, err.Error()))
+		return x
+	}
+
+	f(x.t, v)
+	return x
+}
+
+// HasField checks if a value specified by path can be resolved.
+//
+//	gt.Struct(t, pod).HasField("Spec.Containers[0].Image")
+func (x StructTest[T]) HasField(path string) StructTest[T] {
+	x.t.Helper()
+
+	if _, err := lookupField(reflect.ValueOf(x.actual), path); err != nil {
+		x.t.Error(formatErrorMessage(x.description, err.Error()))
+	}
+	return x
+}
+
+// FieldEqual checks if a value specified by path equals expect. Default evaluation function uses reflect.DeepEqual, then type of expect must be the same with the field.
+//
+//	gt.Struct(t, pod).FieldEqual("Spec.Replicas", 3)
+func (x StructTest[T]) FieldEqual(path string, expect any) StructTest[T] {
+	x.t.Helper()
+
+	v, err := lookupField(reflect.ValueOf(x.actual), path)
+	if err != nil {
+		x.t.Error(formatErrorMessage(x.description, err.Error()))
+		return x
+	}
+
+	if !evalCompare(x.t, v, expect) {
+		msg := fmt.Sprintf("field %q is not matched\n", path) + evalDiff(x.t, expect, v)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// Matches checks if actual matches with partial. Only fields that are set (not zero value) in partial are compared, and nested structs are compared in the same manner. Unexported fields are not compared.
+//
+//	type user struct {
+//		ID   int
+//		Name string
+//		Age  int
+//	}
+//	u := user{ID: 1, Name: "Alice", Age: 20}
+//	gt.Struct(t, u).Matches(user{Name: "Alice"}) // Pass
+//	gt.Struct(t, u).Matches(user{Age: 21})       // Fail
+func (x StructTest[T]) Matches(partial T) StructTest[T] {
+	x.t.Helper()
+
+	var diffs []string
+	x.matchValue("", reflect.ValueOf(partial), reflect.ValueOf(x.actual), &diffs)
+
+	if len(diffs) > 0 {
+		msg := "struct is not matched with partial\n" + strings.Join(diffs, "\n")
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+func (x StructTest[T]) matchValue(path string, expect, actual reflect.Value, diffs *[]string) {
+	at := path
+	if at == "" {
+		at = "(root)"
+	}
+
+	if !expect.IsValid() || expect.IsZero() {
+		return
+	}
+	if !actual.IsValid() {
+		*diffs = append(*diffs, fmt.Sprintf("%s: expect %+v, actual nil", at, expect.Interface()))
+		return
+	}
+	if expect.Type() != actual.Type() {
+		*diffs = append(*diffs, fmt.Sprintf("%s: expect %s, actual %s", at, expect.Type(), actual.Type()))
+		return
+	}
+
+	switch expect.Kind() {
+	case reflect.Struct:
+		for i := 0; i < expect.NumField(); i++ {
+			field := expect.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			p := field.Name
+			if path != "" {
+				p = path + "." + field.Name
+			}
+			x.matchValue(p, expect.Field(i), actual.Field(i), diffs)
+		}
+
+	case reflect.Pointer, reflect.Interface:
+		if actual.IsNil() {
+			*diffs = append(*diffs, fmt.Sprintf("%s: expect %+v, actual nil", at, expect.Elem()))
+			return
+		}
+		if expect.Kind() == reflect.Interface && expect.Elem().Type() != actual.Elem().Type() {
+			*diffs = append(*diffs, fmt.Sprintf("%s: expect %s, actual %s", at, expect.Elem().Type(), actual.Elem().Type()))
+			return
+		}
+		x.matchValue(path, expect.Elem(), actual.Elem(), diffs)
+
+	default:
+		if !evalCompare(x.t, expect.Interface(), actual.Interface()) {
+			*diffs = append(*diffs, fmt.Sprintf("%s: expect %+v, actual %+v", at, expect.Interface(), actual.Interface()))
+		}
+	}
+}
+
+// lookupField resolves path in v. Error message includes the path segment that failed.
+func lookupField(v reflect.Value, path string) (any, error) {
+	segments, err := parseFieldPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cur := v
+	resolved := ""
+	for _, seg := range segments {
+		for cur.IsValid() && (cur.Kind() == reflect.Pointer || cur.Kind() == reflect.Interface) {
+			if cur.IsNil() {
+				return nil, fmt.Errorf("path %q: nil %s at %q", path, cur.Kind(), resolvedOrRoot(resolved))
+			}
+			cur = cur.Elem()
+		}
+		if !cur.IsValid() {
+			return nil, fmt.Errorf("path %q: nil value at %q", path, resolvedOrRoot(resolved))
+		}
+
+		if seg.index == nil {
+			walked := resolved
+			resolved = joinFieldPath(resolved, seg.name)
+			if cur.Kind() != reflect.Struct {
+				return nil, fmt.Errorf("path %q: %s is not struct at %q", path, cur.Type(), resolved)
+			}
+			field, ok := cur.Type().FieldByName(seg.name)
+			if !ok {
+				return nil, fmt.Errorf("path %q: field is not found at %q in %s", path, resolved, cur.Type())
+			}
+			if !field.IsExported() {
+				return nil, fmt.Errorf("path %q: field is unexported at %q", path, resolved)
+			}
+			// Walk field.Index step by step because FieldByIndex panics at a nil embedded pointer of a promoted field.
+			for _, idx := range field.Index {
+				if cur.Kind() == reflect.Pointer {
+					if cur.IsNil() {
+						return nil, fmt.Errorf("path %q: nil pointer at %q", path, walked)
+					}
+					cur = cur.Elem()
+				}
+				walked = joinFieldPath(walked, cur.Type().Field(idx).Name)
+				cur = cur.Field(idx)
+			}
+			continue
+		}
+
+		key := *seg.index
+		resolved += "[" + key + "]"
+		switch cur.Kind() {
+		case reflect.Slice, reflect.Array:
+			idx, err := strconv.Atoi(key)
+			if err != nil {
+				return nil, fmt.Errorf("path %q: invalid index at %q", path, resolved)
+			}
+			if idx < 0 || cur.Len() <= idx {
+				return nil, fmt.Errorf("path %q: index out of range at %q (length %d)", path, resolved, cur.Len())
+			}
+			cur = cur.Index(idx)
+
+		case reflect.Map:
+			mapKey, err := convertMapKey(key, cur.Type().Key())
+			if err != nil {
+				return nil, fmt.Errorf("path %q: invalid map key at %q, %v", path, resolved, err)
+			}
+			next := cur.MapIndex(mapKey)
+			if !next.IsValid() {
+				return nil, fmt.Errorf("path %q: map key is not found at %q", path, resolved)
+			}
+			cur = next
+
+		default:
+			return nil, fmt.Errorf("path %q: %s can not be indexed at %q", path, cur.Type(), resolved)
+		}
+	}
+
+	if !cur.IsValid() {
+		return nil, nil
+	}
+	return cur.Interface(), nil
+}
+
+type fieldSegment struct {
+	name  string
+	index *string
+}
+
+func parseFieldPath(path string) ([]fieldSegment, error) {
+	var segments []fieldSegment
+	rest := path
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			if len(segments) == 0 {
+				return nil, fmt.Errorf("invalid path %q: unexpected '.' at beginning", path)
+			}
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %q: empty field name", path)
+			}
+			segments = append(segments, fieldSegment{name: rest[:end]})
+			rest = rest[end:]
+
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: missing ']'", path)
+			}
+			key := strings.Trim(rest[1:end], `"`)
+			segments = append(segments, fieldSegment{index: &key})
+			rest = rest[end+1:]
+
+		default:
+			if len(segments) > 0 {
+				return nil, fmt.Errorf("invalid path %q: missing '.' before %q", path, rest)
+			}
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			segments = append(segments, fieldSegment{name: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+
+	return segments, nil
+}
+
+func convertMapKey(key string, typ reflect.Type) (reflect.Value, error) {
+	switch typ.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(key).Convert(typ), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(key, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(n).Convert(typ), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(key, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(n).Convert(typ), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported key type %s", typ)
+	}
+}
+
+func joinFieldPath(base, name string) string {
+	if base == "" {
+		return name
+	}
+	return base + "." + name
+}
+
+func resolvedOrRoot(resolved string) string {
+	if resolved == "" {
+		return "(root)"
+	}
+	return resolved
+}
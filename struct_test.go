@@ -0,0 +1,241 @@
+package gt_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+type structContainer struct {
+	Name  string
+	Image string
+}
+
+type structSpec struct {
+	Replicas   int
+	Containers []structContainer
+	Template   *structContainer
+}
+
+type structPod struct {
+	Name   string
+	Labels map[string]string
+	Ports  map[int]string
+	Spec   *structSpec
+	hidden string
+}
+
+func newStructPod() structPod {
+	return structPod{
+		Name:   "web",
+		Labels: map[string]string{"app": "web"},
+		Ports:  map[int]string{80: "http"},
+		Spec: &structSpec{
+			Replicas:   3,
+			Containers: []structContainer{{Name: "nginx", Image: "nginx:latest"}},
+		},
+		hidden: "x",
+	}
+}
+
+func TestStruct(t *testing.T) {
+	testCases := map[string]struct {
+		test func(s gt.StructTest[structPod])
+		pass bool
+		msg  string
+	}{
+		"FieldEqual: pass": {
+			test: func(s gt.StructTest[structPod]) {
+				s.FieldEqual("Spec.Containers[0].Image", "nginx:latest").
+					FieldEqual("Spec.Replicas", 3).
+					FieldEqual("Labels[app]", "web").
+					FieldEqual(`Labels["app"]`, "web").
+					FieldEqual("Ports[80]", "http")
+			},
+			pass: true,
+		},
+		"FieldEqual: fail": {
+			test: func(s gt.StructTest[structPod]) {
+				s.FieldEqual("Spec.Containers[0].Image", "nginx:1.0")
+			},
+			pass: false,
+			msg:  `field "Spec.Containers[0].Image" is not matched`,
+		},
+		"HasField: pass": {
+			test: func(s gt.StructTest[structPod]) {
+				s.HasField("Spec.Containers[0].Name")
+			},
+			pass: true,
+		},
+		"HasField: missing field": {
+			test: func(s gt.StructTest[structPod]) {
+				s.HasField("Spec.Volumes")
+			},
+			pass: false,
+			msg:  `field is not found at "Spec.Volumes"`,
+		},
+		"HasField: out of range": {
+			test: func(s gt.StructTest[structPod]) {
+				s.HasField("Spec.Containers[1].Name")
+			},
+			pass: false,
+			msg:  `index out of range at "Spec.Containers[1]" (length 1)`,
+		},
+		"HasField: nil pointer": {
+			test: func(s gt.StructTest[structPod]) {
+				s.HasField("Spec.Template.Name")
+			},
+			pass: false,
+			msg:  `nil ptr at "Spec.Template"`,
+		},
+		"HasField: missing map key": {
+			test: func(s gt.StructTest[structPod]) {
+				s.HasField("Labels[env]")
+			},
+			pass: false,
+			msg:  `map key is not found at "Labels[env]"`,
+		},
+		"HasField: unexported": {
+			test: func(s gt.StructTest[structPod]) {
+				s.HasField("hidden")
+			},
+			pass: false,
+			msg:  `field is unexported at "hidden"`,
+		},
+		"Matches: pass": {
+			test: func(s gt.StructTest[structPod]) {
+				s.Matches(structPod{
+					Name: "web",
+					Spec: &structSpec{Replicas: 3},
+				})
+			},
+			pass: true,
+		},
+		"Matches: fail": {
+			test: func(s gt.StructTest[structPod]) {
+				s.Matches(structPod{
+					Name: "web",
+					Spec: &structSpec{Replicas: 2},
+				})
+			},
+			pass: false,
+			msg:  "Spec.Replicas: expect 2, actual 3",
+		},
+		"Matches: ignore unexported": {
+			test: func(s gt.StructTest[structPod]) {
+				s.Matches(structPod{hidden: "y"})
+			},
+			pass: true,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.Struct(r, newStructPod()))
+			if tc.pass != (r.errs == 0) {
+				t.Fatalf("unexpected result: %v", r.msgs)
+			}
+			if tc.msg != "" && !strings.Contains(r.msgs[0], tc.msg) {
+				t.Errorf("message should contain %q, but %q", tc.msg, r.msgs[0])
+			}
+		})
+	}
+}
+
+func TestStructField(t *testing.T) {
+	var called int
+	gt.Struct(t, newStructPod()).Field("Spec.Containers[0]", func(t testing.TB, v any) {
+		called++
+		c := gt.Cast[structContainer](t, v)
+		gt.String(t, c.Image).HasPrefix("nginx")
+	})
+	if called != 1 {
+		t.Errorf("callback should be called once, but %d", called)
+	}
+
+	pod := newStructPod()
+	gt.Struct(t, &pod).FieldEqual("Spec.Replicas", 3)
+}
+
+func TestStructMatchesWithAny(t *testing.T) {
+	testCases := map[string]struct {
+		actual any
+		pass   bool
+		msg    string
+	}{
+		"pass": {
+			actual: newStructPod(),
+			pass:   true,
+		},
+		"fail with nil": {
+			actual: nil,
+			pass:   false,
+			msg:    "(root): expect {Name:web",
+		},
+		"fail with pointer": {
+			actual: &structPod{Name: "web"},
+			pass:   false,
+			msg:    "(root): expect gt_test.structPod, actual *gt_test.structPod",
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			gt.Struct[any](r, tc.actual).Matches(structPod{Name: "web"})
+			if tc.pass != (r.errs == 0) {
+				t.Fatalf("unexpected result: %v", r.msgs)
+			}
+			if tc.msg != "" && !strings.Contains(r.msgs[0], tc.msg) {
+				t.Errorf("unexpected message: %s", r.msgs[0])
+			}
+		})
+	}
+
+	t.Run("nested interface with different type", func(t *testing.T) {
+		type holder struct{ V any }
+		r := newRecorder()
+		gt.Struct(r, holder{V: 1}).Matches(holder{V: "1"})
+		gt.Number(t, r.errs).Equal(1)
+		gt.S(t, r.msgs[0]).Contains("V: expect string, actual int")
+	})
+}
+
+type structInner struct {
+	Name string
+}
+
+type structOuter struct {
+	*structInner
+	ID int
+}
+
+type StructEmbedded struct {
+	Name string
+}
+
+type structOuterExported struct {
+	*StructEmbedded
+}
+
+func TestStructEmbeddedNilPointer(t *testing.T) {
+	r := newRecorder()
+	gt.Struct(r, structOuterExported{}).HasField("Name")
+	if r.errs != 1 || !strings.Contains(r.msgs[0], `nil pointer at "StructEmbedded"`) {
+		t.Errorf("unexpected result: %v", r.msgs)
+	}
+
+	r = newRecorder()
+	gt.Struct(r, structOuterExported{StructEmbedded: &StructEmbedded{Name: "a"}}).HasField("Name").FieldEqual("Name", "a")
+	if r.errs != 0 {
+		t.Errorf("should pass, but %v", r.msgs)
+	}
+
+	r = newRecorder()
+	gt.Struct(r, structOuter{}).HasField("Name")
+	if r.errs != 1 || !strings.Contains(r.msgs[0], `nil pointer at "structInner"`) {
+		t.Errorf("unexpected result: %v", r.msgs)
+	}
+}
@@ -0,0 +1,257 @@
+package gt
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+type TimeTest struct {
+	TestMeta
+	actual time.Time
+}
+
+// Time provides TimeTest that compares time.Time by time.Time.Equal, not by reflect.DeepEqual. Then monotonic clock reading and location are ignored in comparison.
+//
+//	now := time.Now()
+//	gt.Time(t, now.UTC()).Equal(now) // Pass
+func Time(t testing.TB, actual time.Time) TimeTest {
+	t.Helper()
+	return TimeTest{
+		TestMeta: TestMeta{t: t},
+		actual:   actual,
+	}
+}
+
+// Describe sets a description for the test. The description will be displayed when the test fails.
+func (x TimeTest) Describe(description string) TimeTest {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when the test fails.
+func (x TimeTest) Describef(format string, args ...any) TimeTest {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test. If errors has been occurred in previous test, it immediately stop test by t.FailNow().
+func (x TimeTest) Required() TimeTest {
+	x.requiredWithMeta()
+	return x
+}
+
+func formatTimeDiff(actual, expect time.Time) string {
+	return strings.Join([]string{
+		"actual: " + actual.Format(time.RFC3339Nano),
+		"expect: " + expect.Format(time.RFC3339Nano),
+		fmt.Sprintf("delta: %v", actual.Sub(expect)),
+	}, "\n")
+}
+
+// Equal checks if actual and expect represent the same time instant by time.Time.Equal.
+//
+//	tm := time.Date(2023, 1, 1, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+//	gt.Time(t, tm).Equal(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)) // Pass
+func (x TimeTest) Equal(expect time.Time) TimeTest {
+	x.t.Helper()
+	if !x.actual.Equal(expect) {
+		msg := "times are not matched\n" + formatTimeDiff(x.actual, expect)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// NotEqual checks if actual and expect do not represent the same time instant.
+func (x TimeTest) NotEqual(expect time.Time) TimeTest {
+	x.t.Helper()
+	if x.actual.Equal(expect) {
+		msg := fmt.Sprintf("times should not be matched, %s", x.actual.Format(time.RFC3339Nano))
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// Before checks if actual is before expect.
+//
+//	gt.Time(t, start).Before(end) // Pass if start < end
+func (x TimeTest) Before(expect time.Time) TimeTest {
+	x.t.Helper()
+	if !x.actual.Before(expect) {
+		msg := "time is expected to be before\n" + formatTimeDiff(x.actual, expect)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// After checks if actual is after expect.
+//
+//	gt.Time(t, end).After(start) // Pass if end > start
+func (x TimeTest) After(expect time.Time) TimeTest {
+	x.t.Helper()
+	if !x.actual.After(expect) {
+		msg := "time is expected to be after\n" + formatTimeDiff(x.actual, expect)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// Between checks if actual is in range from start to end. Both start and end are inclusive.
+//
+//	gt.Time(t, createdAt).Between(beforeCall, afterCall)
+func (x TimeTest) Between(start, end time.Time) TimeTest {
+	x.t.Helper()
+	if x.actual.Before(start) || x.actual.After(end) {
+		msg := strings.Join([]string{
+			"time is expected to be between start and end",
+			"actual: " + x.actual.Format(time.RFC3339Nano),
+			"start:  " + start.Format(time.RFC3339Nano),
+			"end:    " + end.Format(time.RFC3339Nano),
+		}, "\n")
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// WithinDuration checks if difference between actual and expect is delta or less.
+//
+//	gt.Time(t, updatedAt).WithinDuration(time.Now(), time.Second)
+func (x TimeTest) WithinDuration(expect time.Time, delta time.Duration) TimeTest {
+	x.t.Helper()
+	diff := x.actual.Sub(expect)
+	if diff < -delta || delta < diff {
+		msg := fmt.Sprintf("time is expected to be within %v\n", delta) + formatTimeDiff(x.actual, expect)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// SameDay checks if actual and expect are the same date. expect is converted to location of actual before comparison.
+//
+//	tm := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+//	gt.Time(t, tm).SameDay(time.Date(2023, 1, 1, 23, 0, 0, 0, time.UTC)) // Pass
+func (x TimeTest) SameDay(expect time.Time) TimeTest {
+	x.t.Helper()
+	ay, am, ad := x.actual.Date()
+	ey, em, ed := expect.In(x.actual.Location()).Date()
+	if ay != ey || am != em || ad != ed {
+		msg := "times are expected to be the same day\n" + formatTimeDiff(x.actual, expect)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// EqualTruncated checks if actual and expect are equal after truncated by d (see time.Time.Truncate).
+//
+//	tm := time.Date(2023, 1, 1, 10, 0, 30, 0, time.UTC)
+//	gt.Time(t, tm).EqualTruncated(time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC), time.Minute) // Pass
+func (x TimeTest) EqualTruncated(expect time.Time, d time.Duration) TimeTest {
+	x.t.Helper()
+	if !x.actual.Truncate(d).Equal(expect.Truncate(d)) {
+		msg := fmt.Sprintf("times truncated by %v are not matched\n", d) + formatTimeDiff(x.actual, expect)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// IsZero checks if actual is zero time.
+func (x TimeTest) IsZero() TimeTest {
+	x.t.Helper()
+	if !x.actual.IsZero() {
+		msg := fmt.Sprintf("time is expected to be zero, but %s", x.actual.Format(time.RFC3339Nano))
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// IsNotZero checks if actual is not zero time.
+func (x TimeTest) IsNotZero() TimeTest {
+	x.t.Helper()
+	if x.actual.IsZero() {
+		msg := "time is expected not to be zero"
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+type DurationTest struct {
+	TestMeta
+	actual time.Duration
+}
+
+// Duration provides DurationTest that has comparison methods for time.Duration.
+//
+//	gt.Duration(t, elapsed).Less(time.Second)
+func Duration(t testing.TB, actual time.Duration) DurationTest {
+	t.Helper()
+	return DurationTest{
+		TestMeta: TestMeta{t: t},
+		actual:   actual,
+	}
+}
+
+// Describe sets a description for the test. The description will be displayed when the test fails.
+func (x DurationTest) Describe(description string) DurationTest {
+	x.setDesc(description)
+	return x
+}
+
+// Describef sets a formatted description for the test. The description will be displayed when the test fails.
+func (x DurationTest) Describef(format string, args ...any) DurationTest {
+	x.setDescf(format, args...)
+	return x
+}
+
+// Required check if error has occurred in previous test. If errors has been occurred in previous test, it immediately stop test by t.FailNow().
+func (x DurationTest) Required() DurationTest {
+	x.requiredWithMeta()
+	return x
+}
+
+// Equal checks if actual equals expect.
+func (x DurationTest) Equal(expect time.Duration) DurationTest {
+	x.t.Helper()
+	if x.actual != expect {
+		msg := fmt.Sprintf("durations are not matched\nactual: %v\nexpect: %v\ndelta: %v", x.actual, expect, x.actual-expect)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// Greater checks if actual is longer than expect.
+//
+//	gt.Duration(t, 2*time.Second).Greater(time.Second) // Pass
+func (x DurationTest) Greater(expect time.Duration) DurationTest {
+	x.t.Helper()
+	if !(expect < x.actual) {
+		msg := fmt.Sprintf("got %v, want greater than %v", x.actual, expect)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// Less checks if actual is shorter than expect.
+//
+//	gt.Duration(t, 500*time.Millisecond).Less(time.Second) // Pass
+func (x DurationTest) Less(expect time.Duration) DurationTest {
+	x.t.Helper()
+	if !(x.actual < expect) {
+		msg := fmt.Sprintf("got %v, want less than %v", x.actual, expect)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
+
+// Within checks if difference between actual and expect is delta or less.
+//
+//	gt.Duration(t, elapsed).Within(time.Second, 100*time.Millisecond)
+func (x DurationTest) Within(expect, delta time.Duration) DurationTest {
+	x.t.Helper()
+	diff := x.actual - expect
+	if diff < -delta || delta < diff {
+		msg := fmt.Sprintf("duration is expected to be within %v\nactual: %v\nexpect: %v\ndelta: %v", delta, x.actual, expect, diff)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+	return x
+}
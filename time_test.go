@@ -0,0 +1,143 @@
+package gt_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestTime(t *testing.T) {
+	base := time.Date(2023, 4, 1, 10, 30, 15, 500, time.UTC)
+	jst := time.FixedZone("JST", 9*60*60)
+
+	testCases := map[string]struct {
+		test func(tm gt.TimeTest)
+		pass bool
+	}{
+		"Equal: pass with different location": {
+			test: func(tm gt.TimeTest) { tm.Equal(base.In(jst)) },
+			pass: true,
+		},
+		"Equal: fail": {
+			test: func(tm gt.TimeTest) { tm.Equal(base.Add(time.Nanosecond)) },
+			pass: false,
+		},
+		"NotEqual: pass": {
+			test: func(tm gt.TimeTest) { tm.NotEqual(base.Add(time.Second)) },
+			pass: true,
+		},
+		"Before: pass": {
+			test: func(tm gt.TimeTest) { tm.Before(base.Add(time.Second)) },
+			pass: true,
+		},
+		"Before: fail": {
+			test: func(tm gt.TimeTest) { tm.Before(base) },
+			pass: false,
+		},
+		"After: pass": {
+			test: func(tm gt.TimeTest) { tm.After(base.Add(-time.Second)) },
+			pass: true,
+		},
+		"After: fail": {
+			test: func(tm gt.TimeTest) { tm.After(base) },
+			pass: false,
+		},
+		"Between: pass with inclusive bound": {
+			test: func(tm gt.TimeTest) { tm.Between(base, base.Add(time.Hour)) },
+			pass: true,
+		},
+		"Between: fail": {
+			test: func(tm gt.TimeTest) { tm.Between(base.Add(time.Second), base.Add(time.Hour)) },
+			pass: false,
+		},
+		"WithinDuration: pass": {
+			test: func(tm gt.TimeTest) { tm.WithinDuration(base.Add(-time.Second), time.Second) },
+			pass: true,
+		},
+		"WithinDuration: fail": {
+			test: func(tm gt.TimeTest) { tm.WithinDuration(base.Add(2*time.Second), time.Second) },
+			pass: false,
+		},
+		"SameDay: pass": {
+			test: func(tm gt.TimeTest) { tm.SameDay(time.Date(2023, 4, 1, 23, 59, 0, 0, time.UTC)) },
+			pass: true,
+		},
+		"SameDay: fail by location": {
+			test: func(tm gt.TimeTest) { tm.SameDay(time.Date(2023, 4, 1, 8, 0, 0, 0, jst)) },
+			pass: false,
+		},
+		"EqualTruncated: pass": {
+			test: func(tm gt.TimeTest) { tm.EqualTruncated(time.Date(2023, 4, 1, 10, 30, 0, 0, time.UTC), time.Minute) },
+			pass: true,
+		},
+		"EqualTruncated: fail": {
+			test: func(tm gt.TimeTest) { tm.EqualTruncated(time.Date(2023, 4, 1, 10, 31, 0, 0, time.UTC), time.Minute) },
+			pass: false,
+		},
+		"IsNotZero: pass": {
+			test: func(tm gt.TimeTest) { tm.IsNotZero() },
+			pass: true,
+		},
+		"IsZero: fail": {
+			test: func(tm gt.TimeTest) { tm.IsZero() },
+			pass: false,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.Time(r, base))
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestTimeMonotonic(t *testing.T) {
+	now := time.Now()
+	gt.Time(t, now.Round(0)).Equal(now)
+}
+
+func TestTimeMessage(t *testing.T) {
+	base := time.Date(2023, 4, 1, 10, 30, 15, 0, time.UTC)
+	r := newRecorder()
+	gt.Time(r, base).Equal(base.Add(1500 * time.Millisecond))
+	if r.errs != 1 {
+		t.Fatal("should fail")
+	}
+	for _, s := range []string{"2023-04-01T10:30:15Z", "2023-04-01T10:30:16.5Z", "delta: -1.5s"} {
+		if !strings.Contains(r.msgs[0], s) {
+			t.Errorf("message should contain %q: %s", s, r.msgs[0])
+		}
+	}
+}
+
+func TestDuration(t *testing.T) {
+	testCases := map[string]struct {
+		test func(d gt.DurationTest)
+		pass bool
+	}{
+		"Equal: pass":   {test: func(d gt.DurationTest) { d.Equal(time.Second) }, pass: true},
+		"Equal: fail":   {test: func(d gt.DurationTest) { d.Equal(time.Minute) }, pass: false},
+		"Greater: pass": {test: func(d gt.DurationTest) { d.Greater(time.Millisecond) }, pass: true},
+		"Greater: fail": {test: func(d gt.DurationTest) { d.Greater(time.Second) }, pass: false},
+		"Less: pass":    {test: func(d gt.DurationTest) { d.Less(time.Minute) }, pass: true},
+		"Less: fail":    {test: func(d gt.DurationTest) { d.Less(time.Second) }, pass: false},
+		"Within: pass":  {test: func(d gt.DurationTest) { d.Within(1100*time.Millisecond, 100*time.Millisecond) }, pass: true},
+		"Within: fail":  {test: func(d gt.DurationTest) { d.Within(1200*time.Millisecond, 100*time.Millisecond) }, pass: false},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(gt.Duration(r, time.Second))
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package gt
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// MapArray converts each element of x by f and returns ArrayTest of converted elements. testing.TB and description of x are kept.
+//
+//	gt.MapArray(gt.Array(t, users), func(u User) string {
+//		return u.ID
+//	}).Equal([]string{"u1", "u2"})
+func MapArray[T, U any](x ArrayTest[T], f func(v T) U) ArrayTest[U] {
+	x.t.Helper()
+
+	var converted []U
+	if x.actual != nil {
+		converted = make([]U, len(x.actual))
+	}
+	for i := range x.actual {
+		converted[i] = f(x.actual[i])
+	}
+
+	return ArrayTest[U]{
+		TestMeta: x.TestMeta,
+		actual:   converted,
+	}
+}
+
+// Filter returns ArrayTest of elements that f returns true. testing.TB and description of x are kept.
+//
+//	gt.Filter(gt.Array(t, users), func(u User) bool {
+//		return u.Role == "admin"
+//	}).Length(1)
+func Filter[T any](x ArrayTest[T], f func(v T) bool) ArrayTest[T] {
+	x.t.Helper()
+
+	filtered := []T{}
+	for i := range x.actual {
+		if f(x.actual[i]) {
+			filtered = append(filtered, x.actual[i])
+		}
+	}
+
+	return ArrayTest[T]{
+		TestMeta: x.TestMeta,
+		actual:   filtered,
+	}
+}
+
+// Keys returns ArrayTest of keys in the map. Keys of integer, float and string types are sorted in ascending order, and keys of other types are sorted in order of formatted key (by fmt.Sprintf("%+v")) to make the order stable. testing.TB and description of x are kept.
+//
+//	gt.Keys(gt.Map(t, m)).Equal([]string{"blue", "red"})
+func Keys[K comparable, V any](x MapTest[K, V]) ArrayTest[K] {
+	x.t.Helper()
+
+	return ArrayTest[K]{
+		TestMeta: x.TestMeta,
+		actual:   sortedKeys(x.actual),
+	}
+}
+
+// Values returns ArrayTest of values in the map. Values are in order of keys as same as Keys. testing.TB and description of x are kept.
+//
+//	gt.Values(gt.Map(t, m)).Has(5)
+func Values[K comparable, V any](x MapTest[K, V]) ArrayTest[V] {
+	x.t.Helper()
+
+	keys := sortedKeys(x.actual)
+	values := make([]V, len(keys))
+	for i, k := range keys {
+		values[i] = x.actual[k]
+	}
+
+	return ArrayTest[V]{
+		TestMeta: x.TestMeta,
+		actual:   values,
+	}
+}
+
+// sortedKeys returns keys of m in ascending order if the key type is integer, float or string. Keys of other types are ordered by formatted key.
+func sortedKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	// Decide by static key type so that keys of interface type holding different types are ordered consistently.
+	switch reflect.TypeOf((*K)(nil)).Elem().Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.String:
+		sort.Slice(keys, func(i, j int) bool {
+			c, _ := compareOrdered(keys[i], keys[j])
+			return c < 0
+		})
+		return keys
+	}
+
+	labels := make(map[K]string, len(m))
+	for _, k := range keys {
+		labels[k] = fmt.Sprintf("%+v", k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return labels[keys[i]] < labels[keys[j]]
+	})
+	return keys
+}
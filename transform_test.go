@@ -0,0 +1,121 @@
+package gt_test
+
+import (
+	"testing"
+
+	"github.com/m-mizutani/gt"
+)
+
+func TestTransform(t *testing.T) {
+	type user struct {
+		ID   string
+		Role string
+	}
+	users := []user{{"u1", "admin"}, {"u2", "member"}, {"u3", "admin"}}
+	colors := map[string]int{"red": 1, "blue": 5, "green": 3}
+
+	testCases := map[string]struct {
+		test func(t testing.TB)
+		pass bool
+	}{
+		"MapArray pass": {
+			test: func(t testing.TB) {
+				gt.MapArray(gt.Array(t, users), func(u user) string { return u.ID }).
+					Equal([]string{"u1", "u2", "u3"})
+			},
+			pass: true,
+		},
+		"MapArray fail": {
+			test: func(t testing.TB) {
+				gt.MapArray(gt.Array(t, users), func(u user) string { return u.ID }).Has("u4")
+			},
+			pass: false,
+		},
+		"Filter pass": {
+			test: func(t testing.TB) {
+				gt.Filter(gt.Array(t, users), func(u user) bool { return u.Role == "admin" }).Length(2)
+			},
+			pass: true,
+		},
+		"Filter pass with no match": {
+			test: func(t testing.TB) {
+				gt.Filter(gt.Array(t, users), func(u user) bool { return u.Role == "owner" }).Equal([]user{})
+			},
+			pass: true,
+		},
+		"Keys pass": {
+			test: func(t testing.TB) {
+				gt.Keys(gt.Map(t, colors)).Equal([]string{"blue", "green", "red"})
+			},
+			pass: true,
+		},
+		"Values pass": {
+			test: func(t testing.TB) {
+				gt.Values(gt.Map(t, colors)).Equal([]int{5, 3, 1})
+			},
+			pass: true,
+		},
+		"Keys pass with int keys in numeric order": {
+			test: func(t testing.TB) {
+				gt.Keys(gt.Map(t, map[int]int{1: 0, 2: 0, 10: 0, -3: 0})).Equal([]int{-3, 1, 2, 10})
+			},
+			pass: true,
+		},
+		"Values pass in numeric order of float keys": {
+			test: func(t testing.TB) {
+				gt.Values(gt.Map(t, map[float64]string{10.5: "c", 2: "b", -1: "a"})).Equal([]string{"a", "b", "c"})
+			},
+			pass: true,
+		},
+		"Keys pass with struct keys": {
+			test: func(t testing.TB) {
+				type pt struct{ X, Y int }
+				gt.Keys(gt.Map(t, map[pt]int{{2, 1}: 0, {1, 2}: 0})).Equal([]pt{{1, 2}, {2, 1}})
+			},
+			pass: true,
+		},
+		"Values fail": {
+			test: func(t testing.TB) {
+				gt.Values(gt.Map(t, colors)).Has(2)
+			},
+			pass: false,
+		},
+		"Array Len pass": {
+			test: func(t testing.TB) { gt.Array(t, users).Len().Between(1, 3) },
+			pass: true,
+		},
+		"Array Len fail": {
+			test: func(t testing.TB) { gt.Array(t, users).Len().Greater(3) },
+			pass: false,
+		},
+		"Map Len pass": {
+			test: func(t testing.TB) { gt.Map(t, colors).Len().Equal(3) },
+			pass: true,
+		},
+	}
+
+	for title, tc := range testCases {
+		t.Run(title, func(t *testing.T) {
+			r := newRecorder()
+			tc.test(r)
+			if tc.pass != (r.errs == 0) {
+				t.Errorf("unexpected result: %v", r.msgs)
+			}
+		})
+	}
+}
+
+func TestTransformKeepsDescription(t *testing.T) {
+	r := newRecorder()
+	arr := gt.Array(r, []int{1, 2, 3}).Describe("numbers")
+	gt.MapArray(arr, func(v int) int { return v * 2 }).Has(5)
+	gt.Filter(arr, func(v int) bool { return v > 1 }).Length(3)
+	arr.Len().Equal(4)
+	gt.Keys(gt.Map(r, map[string]int{"a": 1}).Describe("keys")).Has("b")
+
+	gt.Number(t, r.errs).Equal(4)
+	for _, msg := range r.msgs[:3] {
+		gt.String(t, msg).HasPrefix("numbers\n")
+	}
+	gt.String(t, r.msgs[3]).HasPrefix("keys\n")
+}
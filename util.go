@@ -58,7 +58,10 @@ var EvalFileExists = func(path string) bool {
 	return false
 }
 
-var Diff = func(expect, actual any) string {
+// Diff is a function to show difference of expect and actual in failure message. A developer can replace Diff with own diff function if needed.
+var Diff = defaultDiff
+
+func defaultDiff(expect, actual any) string {
 	switch reflect.ValueOf(actual).Kind() {
 	case reflect.Pointer, reflect.UnsafePointer,
 		reflect.Array, reflect.Slice,
@@ -73,8 +76,9 @@ var Diff = func(expect, actual any) string {
 	}
 }
 
+// DumpError is a function to format error for failure message of NoError. Default function shows error message, and also attributes and stack trace extracted by ErrorExtractors if available. A developer can replace DumpError with own format function if needed.
 var DumpError = func(err error) string {
-	return err.Error()
+	return dumpErrorDetail(err, ErrorExtractors)
 }
 
 func required(t testing.TB) {
@@ -100,3 +104,144 @@ func formatErrorMessage(description, originalMessage string) string {
 	}
 	return fmt.Sprintf("%s\n%s", description, originalMessage)
 }
+
+// maxLineDiffCells limits size of LCS table in lineDiff to avoid huge memory allocation with large inputs.
+const maxLineDiffCells = 4 * 1024 * 1024
+
+// lineDiff returns unified style line diff between expect and actual. Lines only in expect are prefixed with "-" and lines only in actual are prefixed with "+". Unchanged lines around changes are shown as context.
+func lineDiff(expect, actual string) string {
+	a := splitLines(expect)
+	b := splitLines(actual)
+
+	type op struct {
+		kind byte // ' ', '-' or '+'
+		line string
+	}
+	var ops []op
+
+	// Trim common prefix and suffix to keep LCS table small
+	head := 0
+	for head < len(a) && head < len(b) && a[head] == b[head] {
+		head++
+	}
+	tail := 0
+	for tail < len(a)-head && tail < len(b)-head && a[len(a)-1-tail] == b[len(b)-1-tail] {
+		tail++
+	}
+	for _, line := range a[:head] {
+		ops = append(ops, op{' ', line})
+	}
+
+	ma, mb := a[head:len(a)-tail], b[head:len(b)-tail]
+	if len(ma)*len(mb) > maxLineDiffCells {
+		for _, line := range ma {
+			ops = append(ops, op{'-', line})
+		}
+		for _, line := range mb {
+			ops = append(ops, op{'+', line})
+		}
+	} else {
+		// lcs[i][j] is length of LCS of ma[i:] and mb[j:]
+		lcs := make([][]int32, len(ma)+1)
+		for i := range lcs {
+			lcs[i] = make([]int32, len(mb)+1)
+		}
+		for i := len(ma) - 1; i >= 0; i-- {
+			for j := len(mb) - 1; j >= 0; j-- {
+				if ma[i] == mb[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else if lcs[i+1][j] >= lcs[i][j+1] {
+					lcs[i][j] = lcs[i+1][j]
+				} else {
+					lcs[i][j] = lcs[i][j+1]
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(ma) || j < len(mb) {
+			switch {
+			case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+				ops = append(ops, op{' ', ma[i]})
+				i++
+				j++
+			case j >= len(mb) || (i < len(ma) && lcs[i+1][j] >= lcs[i][j+1]):
+				ops = append(ops, op{'-', ma[i]})
+				i++
+			default:
+				ops = append(ops, op{'+', mb[j]})
+				j++
+			}
+		}
+	}
+
+	for _, line := range a[len(a)-tail:] {
+		ops = append(ops, op{' ', line})
+	}
+
+	// Render only changed lines and 3 lines of context around them
+	const context = 3
+	show := make([]bool, len(ops))
+	for i := range ops {
+		if ops[i].kind == ' ' {
+			continue
+		}
+		for j := i - context; j <= i+context; j++ {
+			if 0 <= j && j < len(ops) {
+				show[j] = true
+			}
+		}
+	}
+
+	var b2 strings.Builder
+	b2.WriteString("--- expect\n+++ actual\n")
+	expectLine, actualLine := 1, 1
+	for i := 0; i < len(ops); {
+		if !show[i] {
+			if ops[i].kind != '+' {
+				expectLine++
+			}
+			if ops[i].kind != '-' {
+				actualLine++
+			}
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(ops) && show[end] {
+			end++
+		}
+		var expectLen, actualLen int
+		for _, o := range ops[i:end] {
+			if o.kind != '+' {
+				expectLen++
+			}
+			if o.kind != '-' {
+				actualLen++
+			}
+		}
+		fmt.Fprintf(&b2, "@@ -%d,%d +%d,%d @@\n", expectLine, expectLen, actualLine, actualLen)
+		for _, o := range ops[i:end] {
+			line := strings.TrimSuffix(o.line, "\n")
+			if !strings.HasSuffix(o.line, "\n") {
+				line += "\n\\ No newline at end"
+			}
+			fmt.Fprintf(&b2, "%c%s\n", o.kind, line)
+		}
+		expectLine += expectLen
+		actualLine += actualLen
+		i = end
+	}
+
+	return strings.TrimSuffix(b2.String(), "\n")
+}
+
+// splitLines splits s into lines with keeping line terminators.
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
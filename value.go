@@ -51,8 +51,27 @@ func (x ValueTest[T]) Describef(format string, args ...any) ValueTest[T] {
 //	gt.Value(t, u1).Equal(user{Name: "orange"}) // Fail
 func (x ValueTest[T]) Equal(expect T) ValueTest[T] {
 	x.t.Helper()
-	if !EvalCompare(x.actual, expect) {
-		msg := "values are not matched\n" + Diff(expect, x.actual)
+	if !evalCompare(x.t, x.actual, expect) {
+		msg := "values are not matched\n" + evalDiff(x.t, expect, x.actual)
+		x.t.Error(formatErrorMessage(x.description, msg))
+	}
+
+	return x
+}
+
+// EqualWith check if actual equals with expect by go-cmp with options. The options are applied to both comparison and diff output. Unlike Equal, EqualWith does not use EvalCompare.
+//
+//	type user struct {
+//	  Name      string
+//	  UpdatedAt time.Time
+//	}
+//	u1 := user{Name: "blue", UpdatedAt: time.Now()}
+//	gt.Value(t, u1).EqualWith(user{Name: "blue"}, gt.IgnoreFields("UpdatedAt")) // Pass
+func (x ValueTest[T]) EqualWith(expect T, options ...CompareOption) ValueTest[T] {
+	x.t.Helper()
+	cfg := newCompareConfig(options)
+	if !cfg.equal(expect, x.actual) {
+		msg := "values are not matched\n" + cfg.diff(expect, x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
 
@@ -69,7 +88,7 @@ func (x ValueTest[T]) Equal(expect T) ValueTest[T] {
 //	gt.Value(t, u1).NotEqual(user{Name: "orange"}) // Pass
 func (x ValueTest[T]) NotEqual(expect T) ValueTest[T] {
 	x.t.Helper()
-	if EvalCompare(x.actual, expect) {
+	if evalCompare(x.t, x.actual, expect) {
 		msg := fmt.Sprintf("values should not be matched, %+v", x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -86,7 +105,7 @@ func (x ValueTest[T]) NotEqual(expect T) ValueTest[T] {
 func (x ValueTest[T]) Nil() ValueTest[T] {
 	x.t.Helper()
 
-	if !EvalIsNil(x.actual) {
+	if !evalIsNil(x.t, x.actual) {
 		msg := fmt.Sprintf("expected nil, but got %+v (%T)", x.actual, x.actual)
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -103,7 +122,7 @@ func (x ValueTest[T]) Nil() ValueTest[T] {
 func (x ValueTest[T]) NotNil() ValueTest[T] {
 	x.t.Helper()
 
-	if EvalIsNil(x.actual) {
+	if evalIsNil(x.t, x.actual) {
 		msg := "expected not nil, but got nil"
 		x.t.Error(formatErrorMessage(x.description, msg))
 	}
@@ -116,7 +135,7 @@ func (x ValueTest[T]) In(expects ...T) ValueTest[T] {
 	x.t.Helper()
 
 	for i := range expects {
-		if EvalCompare(x.actual, expects[i]) {
+		if evalCompare(x.t, x.actual, expects[i]) {
 			return x
 		}
 	}